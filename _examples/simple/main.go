@@ -8,7 +8,6 @@ import (
 	"os"
 	"os/signal"
 	"strings"
-	"sync"
 	"syscall"
 
 	"github.com/xo/terminfo"
@@ -23,6 +22,13 @@ func main() {
 		log.Fatal(err)
 	}
 
+	// add the xterm status line caps if terminal is an xterm or has COLORTERM
+	if !ti.HasStatusLine() && (strings.Contains(strings.ToLower(os.Getenv("TERM")), "xterm") || os.Getenv("COLORTERM") == "truecolor") {
+		if sl, err := terminfo.Load("xterm+sl"); err == nil {
+			ti = terminfo.Merge(ti, sl)
+		}
+	}
+
 	// cleanup
 	defer func() {
 		err := recover()
@@ -78,31 +84,12 @@ func termputs(ti *terminfo.Terminfo, row, col int, s string, v ...interface{}) {
 	os.Stdout.Write(buf.Bytes())
 }
 
-// sl is the status line terminfo.
-var sl *terminfo.Terminfo
-
 // termtitle sets the window title.
 func termtitle(ti *terminfo.Terminfo, s string) {
-	var once sync.Once
-	once.Do(func() {
-		if ti.Has(terminfo.HasStatusLine) {
-			return
-		}
-		// load the sl xterm if terminal is an xterm or has COLORTERM
-		if strings.Contains(strings.ToLower(os.Getenv("TERM")), "xterm") || os.Getenv("COLORTERM") == "truecolor" {
-			sl, _ = terminfo.Load("xterm+sl")
-		}
-	})
-	if sl != nil {
-		ti = sl
-	}
-	if !ti.Has(terminfo.HasStatusLine) {
+	buf := new(bytes.Buffer)
+	if err := ti.SetTitle(buf, s); err != nil {
 		return
 	}
-	buf := new(bytes.Buffer)
-	ti.Fprintf(buf, terminfo.ToStatusLine)
-	fmt.Fprint(buf, s)
-	ti.Fprintf(buf, terminfo.FromStatusLine)
 	os.Stdout.Write(buf.Bytes())
 }
 
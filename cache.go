@@ -0,0 +1,150 @@
+package terminfo
+
+import (
+	"container/list"
+	"os"
+	"sync"
+	"time"
+)
+
+// termCache is the terminfo cache used by Load.
+var termCache = newCache()
+
+// cache is a terminfo cache, keyed by the search path and each of the names
+// of the cached terminfos, evicting the least recently used terminfo when
+// full.
+type cache struct {
+	sync.Mutex
+
+	// size is the maximum number of terminfos, or -1 when unbounded.
+	size int
+
+	// checkModTime toggles checking the file modification time.
+	checkModTime bool
+
+	// db is the map of keys to their entries.
+	db map[cacheKey]*list.Element
+
+	// lru is the list of entries, most recently used first.
+	lru *list.List
+}
+
+// cacheKey is the key of a cached terminfo.
+type cacheKey struct {
+	// path is the search path the terminfo was loaded from, joined with ':'.
+	path string
+
+	// name is one of the names of the terminfo.
+	name string
+}
+
+// cacheEntry is a cached terminfo.
+type cacheEntry struct {
+	path    string
+	ti      *Terminfo
+	modTime time.Time
+}
+
+// newCache creates a new, unbounded cache.
+func newCache() *cache {
+	return &cache{
+		size: -1,
+		db:   make(map[cacheKey]*list.Element),
+		lru:  list.New(),
+	}
+}
+
+// get returns the cached terminfo for name loaded from the search path. When
+// checking modification times, a terminfo whose file was modified or removed
+// is evicted.
+func (c *cache) get(path, name string) (*Terminfo, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	el, ok := c.db[cacheKey{path, name}]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*cacheEntry)
+	if c.checkModTime && e.ti.File != "" {
+		if fi, err := os.Stat(e.ti.File); err != nil || !fi.ModTime().Equal(e.modTime) {
+			c.remove(el)
+			return nil, false
+		}
+	}
+	c.lru.MoveToFront(el)
+	return e.ti, true
+}
+
+// put adds the terminfo loaded from the search path to the cache, read from
+// a file modified at modTime.
+func (c *cache) put(path string, ti *Terminfo, modTime time.Time) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.size == 0 {
+		return
+	}
+
+	// remove entries with the same names
+	for _, n := range ti.Names {
+		if el, ok := c.db[cacheKey{path, n}]; ok {
+			c.remove(el)
+		}
+	}
+
+	el := c.lru.PushFront(&cacheEntry{path: path, ti: ti, modTime: modTime})
+	for _, n := range ti.Names {
+		c.db[cacheKey{path, n}] = el
+	}
+	c.evict()
+}
+
+// remove removes the entry el. The cache must be locked.
+func (c *cache) remove(el *list.Element) {
+	e := el.Value.(*cacheEntry)
+	for _, n := range e.ti.Names {
+		if k := (cacheKey{e.path, n}); c.db[k] == el {
+			delete(c.db, k)
+		}
+	}
+	c.lru.Remove(el)
+}
+
+// evict removes the least recently used entries until the cache is within
+// its size. The cache must be locked.
+func (c *cache) evict() {
+	for c.size >= 0 && c.lru.Len() > c.size {
+		c.remove(c.lru.Back())
+	}
+}
+
+// ClearCache removes all terminfos from the cache used by Load.
+func ClearCache() {
+	termCache.Lock()
+	defer termCache.Unlock()
+	termCache.db, termCache.lru = make(map[cacheKey]*list.Element), list.New()
+}
+
+// SetCacheSize sets the maximum number of terminfos (not names) kept in the
+// cache used by Load, evicting the least recently used terminfos
+// when the cache is full. A size of 0 disables the cache, and a negative size
+// (the default) leaves the cache unbounded.
+func SetCacheSize(size int) {
+	termCache.Lock()
+	defer termCache.Unlock()
+	if size < 0 {
+		size = -1
+	}
+	termCache.size = size
+	termCache.evict()
+}
+
+// SetCacheCheckModTime toggles checking the modification time of a cached
+// terminfo's file before it is returned by Load. When the file was modified
+// or removed, the terminfo is evicted and the file is read again.
+func SetCacheCheckModTime(check bool) {
+	termCache.Lock()
+	defer termCache.Unlock()
+	termCache.checkModTime = check
+}
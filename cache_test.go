@@ -0,0 +1,122 @@
+package terminfo
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "terminfo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	other := bytes.Replace(stateEntry, []byte("x|test"), []byte("y|abcd"), 1)
+	writeEntries(t, dir, stateEntry, "x/x")
+	writeEntries(t, dir, other, "y/y")
+
+	defer os.Setenv("TERMINFO", os.Getenv("TERMINFO"))
+	os.Setenv("TERMINFO", dir)
+	defer func() {
+		SetCacheSize(-1)
+		SetCacheCheckModTime(false)
+		ClearCache()
+	}()
+	ClearCache()
+
+	load := func(name string) *Terminfo {
+		ti, err := Load(name)
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		return ti
+	}
+
+	// cached by all names
+	x := load("x")
+	if load("test") != x || load("x") != x {
+		t.Errorf("expected cached terminfo")
+	}
+
+	// clear
+	ClearCache()
+	if load("x") == x {
+		t.Errorf("expected cleared cache")
+	}
+
+	// bounded
+	SetCacheSize(1)
+	x, y := load("x"), load("y")
+	if load("y") != y {
+		t.Errorf("expected y to be cached")
+	}
+	if load("x") == x {
+		t.Errorf("expected x to be evicted")
+	}
+
+	// disabled
+	SetCacheSize(0)
+	if x := load("x"); load("x") == x {
+		t.Errorf("expected cache to be disabled")
+	}
+
+	// modification time
+	SetCacheSize(-1)
+	SetCacheCheckModTime(true)
+	x = load("x")
+	if load("x") != x {
+		t.Errorf("expected cached terminfo")
+	}
+	mtime := time.Now().Add(time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "x/x"), mtime, mtime); err != nil {
+		t.Fatal(err)
+	}
+	if load("x") == x {
+		t.Errorf("expected modified file to be read again")
+	}
+}
+
+func TestCacheOpen(t *testing.T) {
+	a, err := ioutil.TempDir("", "terminfo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(a)
+	b, err := ioutil.TempDir("", "terminfo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(b)
+	writeEntries(t, a, stateEntry, "x/x")
+	writeEntries(t, b, stateEntry, "x/x")
+
+	defer setenv("TERMINFO", b)()
+	defer ClearCache()
+	ClearCache()
+
+	load := func(dir string) {
+		ti, err := Load("x")
+		if err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+		if exp := filepath.Join(dir, "x/x"); ti.File != exp {
+			t.Errorf("expected %s, got: %s", exp, ti.File)
+		}
+	}
+
+	// opening from another directory does not change what is loaded
+	if _, err := Open(a, "x"); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	load(b)
+
+	// changing the search path does not return the cached terminfo
+	os.Setenv("TERMINFO", a)
+	load(a)
+	os.Setenv("TERMINFO", b)
+	load(b)
+}
@@ -0,0 +1,557 @@
+package terminfo
+
+// Code generated by gen.go. DO NOT EDIT.
+
+// capInfos are the bool, num and string capability descriptions.
+var capInfos = [...]CapInfo{
+	{"auto_left_margin", "bw", "bw", CapKindBool, AutoLeftMargin, "cub1 wraps from column 0 to last column", CapCategoryStandard, ""},
+	{"auto_right_margin", "am", "am", CapKindBool, AutoRightMargin, "terminal has automatic margins", CapCategoryStandard, ""},
+	{"no_esc_ctlc", "xsb", "xb", CapKindBool, NoEscCtlc, "beehive (f1=escape, f2=ctrl C)", CapCategoryStandard, ""},
+	{"ceol_standout_glitch", "xhp", "xs", CapKindBool, CeolStandoutGlitch, "standout not erased by overwriting (hp)", CapCategoryStandard, ""},
+	{"eat_newline_glitch", "xenl", "xn", CapKindBool, EatNewlineGlitch, "newline ignored after 80 cols (concept)", CapCategoryStandard, ""},
+	{"erase_overstrike", "eo", "eo", CapKindBool, EraseOverstrike, "can erase overstrikes with a blank", CapCategoryStandard, ""},
+	{"generic_type", "gn", "gn", CapKindBool, GenericType, "generic line type", CapCategoryStandard, ""},
+	{"hard_copy", "hc", "hc", CapKindBool, HardCopy, "hardcopy terminal", CapCategoryStandard, ""},
+	{"has_meta_key", "km", "km", CapKindBool, HasMetaKey, "Has a meta key (i.e., sets 8th-bit)", CapCategoryStandard, ""},
+	{"has_status_line", "hs", "hs", CapKindBool, HasStatusLine, "has extra status line", CapCategoryStandard, ""},
+	{"insert_null_glitch", "in", "in", CapKindBool, InsertNullGlitch, "insert mode distinguishes nulls", CapCategoryStandard, ""},
+	{"memory_above", "da", "da", CapKindBool, MemoryAbove, "display may be retained above the screen", CapCategoryStandard, ""},
+	{"memory_below", "db", "db", CapKindBool, MemoryBelow, "display may be retained below the screen", CapCategoryStandard, ""},
+	{"move_insert_mode", "mir", "mi", CapKindBool, MoveInsertMode, "safe to move while in insert mode", CapCategoryStandard, ""},
+	{"move_standout_mode", "msgr", "ms", CapKindBool, MoveStandoutMode, "safe to move while in standout mode", CapCategoryStandard, ""},
+	{"over_strike", "os", "os", CapKindBool, OverStrike, "terminal can overstrike", CapCategoryStandard, ""},
+	{"status_line_esc_ok", "eslok", "es", CapKindBool, StatusLineEscOk, "escape can be used on the status line", CapCategoryStandard, ""},
+	{"dest_tabs_magic_smso", "xt", "xt", CapKindBool, DestTabsMagicSmso, "tabs destructive, magic so char (t1061)", CapCategoryStandard, ""},
+	{"tilde_glitch", "hz", "hz", CapKindBool, TildeGlitch, "cannot print ~'s (Hazeltine)", CapCategoryStandard, ""},
+	{"transparent_underline", "ul", "ul", CapKindBool, TransparentUnderline, "underline character overstrikes", CapCategoryStandard, ""},
+	{"xon_xoff", "xon", "xo", CapKindBool, XonXoff, "terminal uses xon/xoff handshaking", CapCategoryStandard, ""},
+	{"needs_xon_xoff", "nxon", "nx", CapKindBool, NeedsXonXoff, "padding will not work, xon/xoff required", CapCategoryStandard, ""},
+	{"prtr_silent", "mc5i", "5i", CapKindBool, PrtrSilent, "printer will not echo on screen", CapCategoryStandard, ""},
+	{"hard_cursor", "chts", "HC", CapKindBool, HardCursor, "cursor is hard to see", CapCategoryStandard, ""},
+	{"non_rev_rmcup", "nrrmc", "NR", CapKindBool, NonRevRmcup, "smcup does not reverse rmcup", CapCategoryStandard, ""},
+	{"no_pad_char", "npc", "NP", CapKindBool, NoPadChar, "pad character does not exist", CapCategoryStandard, ""},
+	{"non_dest_scroll_region", "ndscr", "ND", CapKindBool, NonDestScrollRegion, "scrolling region is non-destructive", CapCategoryStandard, ""},
+	{"can_change", "ccc", "cc", CapKindBool, CanChange, "terminal can re-define existing colors", CapCategoryStandard, ""},
+	{"back_color_erase", "bce", "ut", CapKindBool, BackColorErase, "screen erased with background color", CapCategoryStandard, ""},
+	{"hue_lightness_saturation", "hls", "hl", CapKindBool, HueLightnessSaturation, "terminal uses only HLS color notation (Tektronix)", CapCategoryStandard, ""},
+	{"col_addr_glitch", "xhpa", "YA", CapKindBool, ColAddrGlitch, "only positive motion for hpa/mhpa caps", CapCategoryStandard, ""},
+	{"cr_cancels_micro_mode", "crxm", "YB", CapKindBool, CrCancelsMicroMode, "using cr turns off micro mode", CapCategoryStandard, ""},
+	{"has_print_wheel", "daisy", "YC", CapKindBool, HasPrintWheel, "printer needs operator to change character set", CapCategoryStandard, ""},
+	{"row_addr_glitch", "xvpa", "YD", CapKindBool, RowAddrGlitch, "only positive motion for vpa/mvpa caps", CapCategoryStandard, ""},
+	{"semi_auto_right_margin", "sam", "YE", CapKindBool, SemiAutoRightMargin, "printing in last column causes cr", CapCategoryStandard, ""},
+	{"cpi_changes_res", "cpix", "YF", CapKindBool, CpiChangesRes, "changing character pitch changes resolution", CapCategoryStandard, ""},
+	{"lpi_changes_res", "lpix", "YG", CapKindBool, LpiChangesRes, "changing line pitch changes resolution", CapCategoryStandard, ""},
+	{"backspaces_with_bs", "OTbs", "bs", CapKindBool, BackspacesWithBs, "uses ^H to move left", CapCategoryObsolete, ""},
+	{"crt_no_scrolling", "OTns", "ns", CapKindBool, CrtNoScrolling, "crt cannot scroll", CapCategoryObsolete, ""},
+	{"no_correctly_working_cr", "OTnc", "nc", CapKindBool, NoCorrectlyWorkingCr, "no way to go to start of line", CapCategoryObsolete, ""},
+	{"gnu_has_meta_key", "OTMT", "MT", CapKindBool, GnuHasMetaKey, "has meta key", CapCategoryObsolete, ""},
+	{"linefeed_is_newline", "OTNL", "NL", CapKindBool, LinefeedIsNewline, "move down with \\n", CapCategoryObsolete, ""},
+	{"has_hardware_tabs", "OTpt", "pt", CapKindBool, HasHardwareTabs, "has 8-char tabs invoked with ^I", CapCategoryObsolete, ""},
+	{"return_does_clr_eol", "OTxr", "xr", CapKindBool, ReturnDoesClrEol, "return clears the line", CapCategoryObsolete, ""},
+	{"columns", "cols", "co", CapKindNum, Columns, "number of columns in a line", CapCategoryStandard, ""},
+	{"init_tabs", "it", "it", CapKindNum, InitTabs, "tabs initially every # spaces", CapCategoryStandard, ""},
+	{"lines", "lines", "li", CapKindNum, Lines, "number of lines on screen or page", CapCategoryStandard, ""},
+	{"lines_of_memory", "lm", "lm", CapKindNum, LinesOfMemory, "lines of memory if > line. 0 means varies", CapCategoryStandard, ""},
+	{"magic_cookie_glitch", "xmc", "sg", CapKindNum, MagicCookieGlitch, "number of blank characters left by smso or rmso", CapCategoryStandard, ""},
+	{"padding_baud_rate", "pb", "pb", CapKindNum, PaddingBaudRate, "lowest baud rate where padding needed", CapCategoryStandard, ""},
+	{"virtual_terminal", "vt", "vt", CapKindNum, VirtualTerminal, "virtual terminal number (CB/unix)", CapCategoryStandard, ""},
+	{"width_status_line", "wsl", "ws", CapKindNum, WidthStatusLine, "number of columns in status line", CapCategoryStandard, ""},
+	{"num_labels", "nlab", "Nl", CapKindNum, NumLabels, "number of labels on screen", CapCategoryStandard, ""},
+	{"label_height", "lh", "lh", CapKindNum, LabelHeight, "rows in each label", CapCategoryStandard, ""},
+	{"label_width", "lw", "lw", CapKindNum, LabelWidth, "columns in each label", CapCategoryStandard, ""},
+	{"max_attributes", "ma", "ma", CapKindNum, MaxAttributes, "maximum combined attributes terminal can handle", CapCategoryStandard, ""},
+	{"maximum_windows", "wnum", "MW", CapKindNum, MaximumWindows, "maximum number of definable windows", CapCategoryStandard, ""},
+	{"max_colors", "colors", "Co", CapKindNum, MaxColors, "maximum number of colors on screen", CapCategoryStandard, ""},
+	{"max_pairs", "pairs", "pa", CapKindNum, MaxPairs, "maximum number of color-pairs on the screen", CapCategoryStandard, ""},
+	{"no_color_video", "ncv", "NC", CapKindNum, NoColorVideo, "video attributes that cannot be used with colors", CapCategoryStandard, ""},
+	{"buffer_capacity", "bufsz", "Ya", CapKindNum, BufferCapacity, "numbers of bytes buffered before printing", CapCategoryStandard, ""},
+	{"dot_vert_spacing", "spinv", "Yb", CapKindNum, DotVertSpacing, "spacing of pins vertically in pins per inch", CapCategoryStandard, ""},
+	{"dot_horz_spacing", "spinh", "Yc", CapKindNum, DotHorzSpacing, "spacing of dots horizontally in dots per inch", CapCategoryStandard, ""},
+	{"max_micro_address", "maddr", "Yd", CapKindNum, MaxMicroAddress, "maximum value in micro_..._address", CapCategoryStandard, ""},
+	{"max_micro_jump", "mjump", "Ye", CapKindNum, MaxMicroJump, "maximum value in parm_..._micro", CapCategoryStandard, ""},
+	{"micro_col_size", "mcs", "Yf", CapKindNum, MicroColSize, "character step size when in micro mode", CapCategoryStandard, ""},
+	{"micro_line_size", "mls", "Yg", CapKindNum, MicroLineSize, "line step size when in micro mode", CapCategoryStandard, ""},
+	{"number_of_pins", "npins", "Yh", CapKindNum, NumberOfPins, "numbers of pins in print-head", CapCategoryStandard, ""},
+	{"output_res_char", "orc", "Yi", CapKindNum, OutputResChar, "horizontal resolution in units per line", CapCategoryStandard, ""},
+	{"output_res_line", "orl", "Yj", CapKindNum, OutputResLine, "vertical resolution in units per line", CapCategoryStandard, ""},
+	{"output_res_horz_inch", "orhi", "Yk", CapKindNum, OutputResHorzInch, "horizontal resolution in units per inch", CapCategoryStandard, ""},
+	{"output_res_vert_inch", "orvi", "Yl", CapKindNum, OutputResVertInch, "vertical resolution in units per inch", CapCategoryStandard, ""},
+	{"print_rate", "cps", "Ym", CapKindNum, PrintRate, "print rate in characters per second", CapCategoryStandard, ""},
+	{"wide_char_size", "widcs", "Yn", CapKindNum, WideCharSize, "character step size when in double wide mode", CapCategoryStandard, ""},
+	{"buttons", "btns", "BT", CapKindNum, Buttons, "number of buttons on mouse", CapCategoryStandard, ""},
+	{"bit_image_entwining", "bitwin", "Yo", CapKindNum, BitImageEntwining, "number of passes for each bit-image row", CapCategoryStandard, ""},
+	{"bit_image_type", "bitype", "Yp", CapKindNum, BitImageType, "type of bit-image device", CapCategoryStandard, ""},
+	{"magic_cookie_glitch_ul", "OTug", "ug", CapKindNum, MagicCookieGlitchUl, "number of blanks left by ul", CapCategoryObsolete, ""},
+	{"carriage_return_delay", "OTdC", "dC", CapKindNum, CarriageReturnDelay, "pad needed for CR", CapCategoryObsolete, ""},
+	{"new_line_delay", "OTdN", "dN", CapKindNum, NewLineDelay, "pad needed for LF", CapCategoryObsolete, ""},
+	{"backspace_delay", "OTdB", "dB", CapKindNum, BackspaceDelay, "padding required for ^H", CapCategoryObsolete, ""},
+	{"horizontal_tab_delay", "OTdT", "dT", CapKindNum, HorizontalTabDelay, "padding required for ^I", CapCategoryObsolete, ""},
+	{"number_of_function_keys", "OTkn", "kn", CapKindNum, NumberOfFunctionKeys, "count of function keys", CapCategoryObsolete, ""},
+	{"back_tab", "cbt", "bt", CapKindString, BackTab, "back tab (P)", CapCategoryStandard, ""},
+	{"bell", "bel", "bl", CapKindString, Bell, "audible signal (bell) (P)", CapCategoryStandard, ""},
+	{"carriage_return", "cr", "cr", CapKindString, CarriageReturn, "carriage return (P*) (P*)", CapCategoryStandard, ""},
+	{"change_scroll_region", "csr", "cs", CapKindString, ChangeScrollRegion, "change region to line #1 to line #2 (P)", CapCategoryStandard, ""},
+	{"clear_all_tabs", "tbc", "ct", CapKindString, ClearAllTabs, "clear all tab stops (P)", CapCategoryStandard, ""},
+	{"clear_screen", "clear", "cl", CapKindString, ClearScreen, "clear screen and home cursor (P*)", CapCategoryStandard, ""},
+	{"clr_eol", "el", "ce", CapKindString, ClrEol, "clear to end of line (P)", CapCategoryStandard, ""},
+	{"clr_eos", "ed", "cd", CapKindString, ClrEos, "clear to end of screen (P*)", CapCategoryStandard, ""},
+	{"column_address", "hpa", "ch", CapKindString, ColumnAddress, "horizontal position #1, absolute (P)", CapCategoryStandard, ""},
+	{"command_character", "cmdch", "CC", CapKindString, CommandCharacter, "terminal settable cmd character in prototype !?", CapCategoryStandard, ""},
+	{"cursor_address", "cup", "cm", CapKindString, CursorAddress, "move to row #1 columns #2", CapCategoryStandard, ""},
+	{"cursor_down", "cud1", "do", CapKindString, CursorDown, "down one line", CapCategoryStandard, ""},
+	{"cursor_home", "home", "ho", CapKindString, CursorHome, "home cursor (if no cup)", CapCategoryStandard, ""},
+	{"cursor_invisible", "civis", "vi", CapKindString, CursorInvisible, "make cursor invisible", CapCategoryStandard, ""},
+	{"cursor_left", "cub1", "le", CapKindString, CursorLeft, "move left one space", CapCategoryStandard, ""},
+	{"cursor_mem_address", "mrcup", "CM", CapKindString, CursorMemAddress, "memory relative cursor addressing, move to row #1 columns #2", CapCategoryStandard, ""},
+	{"cursor_normal", "cnorm", "ve", CapKindString, CursorNormal, "make cursor appear normal (undo civis/cvvis)", CapCategoryStandard, ""},
+	{"cursor_right", "cuf1", "nd", CapKindString, CursorRight, "non-destructive space (move right one space)", CapCategoryStandard, ""},
+	{"cursor_to_ll", "ll", "ll", CapKindString, CursorToLl, "last line, first column (if no cup)", CapCategoryStandard, ""},
+	{"cursor_up", "cuu1", "up", CapKindString, CursorUp, "up one line", CapCategoryStandard, ""},
+	{"cursor_visible", "cvvis", "vs", CapKindString, CursorVisible, "make cursor very visible", CapCategoryStandard, ""},
+	{"delete_character", "dch1", "dc", CapKindString, DeleteCharacter, "delete character (P*)", CapCategoryStandard, ""},
+	{"delete_line", "dl1", "dl", CapKindString, DeleteLine, "delete line (P*)", CapCategoryStandard, ""},
+	{"dis_status_line", "dsl", "ds", CapKindString, DisStatusLine, "disable status line", CapCategoryStandard, ""},
+	{"down_half_line", "hd", "hd", CapKindString, DownHalfLine, "half a line down", CapCategoryStandard, ""},
+	{"enter_alt_charset_mode", "smacs", "as", CapKindString, EnterAltCharsetMode, "start alternate character set (P)", CapCategoryStandard, ""},
+	{"enter_blink_mode", "blink", "mb", CapKindString, EnterBlinkMode, "turn on blinking", CapCategoryStandard, ""},
+	{"enter_bold_mode", "bold", "md", CapKindString, EnterBoldMode, "turn on bold (extra bright) mode", CapCategoryStandard, ""},
+	{"enter_ca_mode", "smcup", "ti", CapKindString, EnterCaMode, "string to start programs using cup", CapCategoryStandard, ""},
+	{"enter_delete_mode", "smdc", "dm", CapKindString, EnterDeleteMode, "enter delete mode", CapCategoryStandard, ""},
+	{"enter_dim_mode", "dim", "mh", CapKindString, EnterDimMode, "turn on half-bright mode", CapCategoryStandard, ""},
+	{"enter_insert_mode", "smir", "im", CapKindString, EnterInsertMode, "enter insert mode", CapCategoryStandard, ""},
+	{"enter_secure_mode", "invis", "mk", CapKindString, EnterSecureMode, "turn on blank mode (characters invisible)", CapCategoryStandard, ""},
+	{"enter_protected_mode", "prot", "mp", CapKindString, EnterProtectedMode, "turn on protected mode", CapCategoryStandard, ""},
+	{"enter_reverse_mode", "rev", "mr", CapKindString, EnterReverseMode, "turn on reverse video mode", CapCategoryStandard, ""},
+	{"enter_standout_mode", "smso", "so", CapKindString, EnterStandoutMode, "begin standout mode", CapCategoryStandard, ""},
+	{"enter_underline_mode", "smul", "us", CapKindString, EnterUnderlineMode, "begin underline mode", CapCategoryStandard, ""},
+	{"erase_chars", "ech", "ec", CapKindString, EraseChars, "erase #1 characters (P)", CapCategoryStandard, ""},
+	{"exit_alt_charset_mode", "rmacs", "ae", CapKindString, ExitAltCharsetMode, "end alternate character set (P)", CapCategoryStandard, ""},
+	{"exit_attribute_mode", "sgr0", "me", CapKindString, ExitAttributeMode, "turn off all attributes", CapCategoryStandard, ""},
+	{"exit_ca_mode", "rmcup", "te", CapKindString, ExitCaMode, "strings to end programs using cup", CapCategoryStandard, ""},
+	{"exit_delete_mode", "rmdc", "ed", CapKindString, ExitDeleteMode, "end delete mode", CapCategoryStandard, ""},
+	{"exit_insert_mode", "rmir", "ei", CapKindString, ExitInsertMode, "exit insert mode", CapCategoryStandard, ""},
+	{"exit_standout_mode", "rmso", "se", CapKindString, ExitStandoutMode, "exit standout mode", CapCategoryStandard, ""},
+	{"exit_underline_mode", "rmul", "ue", CapKindString, ExitUnderlineMode, "exit underline mode", CapCategoryStandard, ""},
+	{"flash_screen", "flash", "vb", CapKindString, FlashScreen, "visible bell (may not move cursor)", CapCategoryStandard, ""},
+	{"form_feed", "ff", "ff", CapKindString, FormFeed, "hardcopy terminal page eject (P*)", CapCategoryStandard, ""},
+	{"from_status_line", "fsl", "fs", CapKindString, FromStatusLine, "return from status line", CapCategoryStandard, ""},
+	{"init_1string", "is1", "i1", CapKindString, Init1string, "initialization string", CapCategoryStandard, ""},
+	{"init_2string", "is2", "is", CapKindString, Init2string, "initialization string", CapCategoryStandard, ""},
+	{"init_3string", "is3", "i3", CapKindString, Init3string, "initialization string", CapCategoryStandard, ""},
+	{"init_file", "if", "if", CapKindString, InitFile, "name of initialization file", CapCategoryStandard, ""},
+	{"insert_character", "ich1", "ic", CapKindString, InsertCharacter, "insert character (P)", CapCategoryStandard, ""},
+	{"insert_line", "il1", "al", CapKindString, InsertLine, "insert line (P*)", CapCategoryStandard, ""},
+	{"insert_padding", "ip", "ip", CapKindString, InsertPadding, "insert padding after inserted character", CapCategoryStandard, ""},
+	{"key_backspace", "kbs", "kb", CapKindString, KeyBackspace, "backspace key", CapCategoryKey, "KEY_BACKSPACE"},
+	{"key_catab", "ktbc", "ka", CapKindString, KeyCatab, "clear-all-tabs key", CapCategoryKey, "KEY_CATAB"},
+	{"key_clear", "kclr", "kC", CapKindString, KeyClear, "clear-screen or erase key", CapCategoryKey, "KEY_CLEAR"},
+	{"key_ctab", "kctab", "kt", CapKindString, KeyCtab, "clear-tab key", CapCategoryKey, "KEY_CTAB"},
+	{"key_dc", "kdch1", "kD", CapKindString, KeyDc, "delete-character key", CapCategoryKey, "KEY_DC"},
+	{"key_dl", "kdl1", "kL", CapKindString, KeyDl, "delete-line key", CapCategoryKey, "KEY_DL"},
+	{"key_down", "kcud1", "kd", CapKindString, KeyDown, "down-arrow key", CapCategoryKey, "KEY_DOWN"},
+	{"key_eic", "krmir", "kM", CapKindString, KeyEic, "sent by rmir or smir in insert mode", CapCategoryKey, "KEY_EIC"},
+	{"key_eol", "kel", "kE", CapKindString, KeyEol, "clear-to-end-of-line key", CapCategoryKey, "KEY_EOL"},
+	{"key_eos", "ked", "kS", CapKindString, KeyEos, "clear-to-end-of-screen key", CapCategoryKey, "KEY_EOS"},
+	{"key_f0", "kf0", "k0", CapKindString, KeyF0, "F0 function key", CapCategoryKey, "KEY_F(0)"},
+	{"key_f1", "kf1", "k1", CapKindString, KeyF1, "F1 function key", CapCategoryKey, "KEY_F(1)"},
+	{"key_f10", "kf10", "k;", CapKindString, KeyF10, "F10 function key", CapCategoryKey, "KEY_F(10)"},
+	{"key_f2", "kf2", "k2", CapKindString, KeyF2, "F2 function key", CapCategoryKey, "KEY_F(2)"},
+	{"key_f3", "kf3", "k3", CapKindString, KeyF3, "F3 function key", CapCategoryKey, "KEY_F(3)"},
+	{"key_f4", "kf4", "k4", CapKindString, KeyF4, "F4 function key", CapCategoryKey, "KEY_F(4)"},
+	{"key_f5", "kf5", "k5", CapKindString, KeyF5, "F5 function key", CapCategoryKey, "KEY_F(5)"},
+	{"key_f6", "kf6", "k6", CapKindString, KeyF6, "F6 function key", CapCategoryKey, "KEY_F(6)"},
+	{"key_f7", "kf7", "k7", CapKindString, KeyF7, "F7 function key", CapCategoryKey, "KEY_F(7)"},
+	{"key_f8", "kf8", "k8", CapKindString, KeyF8, "F8 function key", CapCategoryKey, "KEY_F(8)"},
+	{"key_f9", "kf9", "k9", CapKindString, KeyF9, "F9 function key", CapCategoryKey, "KEY_F(9)"},
+	{"key_home", "khome", "kh", CapKindString, KeyHome, "home key", CapCategoryKey, "KEY_HOME"},
+	{"key_ic", "kich1", "kI", CapKindString, KeyIc, "insert-character key", CapCategoryKey, "KEY_IC"},
+	{"key_il", "kil1", "kA", CapKindString, KeyIl, "insert-line key", CapCategoryKey, "KEY_IL"},
+	{"key_left", "kcub1", "kl", CapKindString, KeyLeft, "left-arrow key", CapCategoryKey, "KEY_LEFT"},
+	{"key_ll", "kll", "kH", CapKindString, KeyLl, "lower-left key (home down)", CapCategoryKey, "KEY_LL"},
+	{"key_npage", "knp", "kN", CapKindString, KeyNpage, "next-page key", CapCategoryKey, "KEY_NPAGE"},
+	{"key_ppage", "kpp", "kP", CapKindString, KeyPpage, "previous-page key", CapCategoryKey, "KEY_PPAGE"},
+	{"key_right", "kcuf1", "kr", CapKindString, KeyRight, "right-arrow key", CapCategoryKey, "KEY_RIGHT"},
+	{"key_sf", "kind", "kF", CapKindString, KeySf, "scroll-forward key", CapCategoryKey, "KEY_SF"},
+	{"key_sr", "kri", "kR", CapKindString, KeySr, "scroll-backward key", CapCategoryKey, "KEY_SR"},
+	{"key_stab", "khts", "kT", CapKindString, KeyStab, "set-tab key", CapCategoryKey, "KEY_STAB"},
+	{"key_up", "kcuu1", "ku", CapKindString, KeyUp, "up-arrow key", CapCategoryKey, "KEY_UP"},
+	{"keypad_local", "rmkx", "ke", CapKindString, KeypadLocal, "leave 'keyboard_transmit' mode", CapCategoryStandard, ""},
+	{"keypad_xmit", "smkx", "ks", CapKindString, KeypadXmit, "enter 'keyboard_transmit' mode", CapCategoryStandard, ""},
+	{"lab_f0", "lf0", "l0", CapKindString, LabF0, "label on function key f0 if not f0", CapCategoryStandard, ""},
+	{"lab_f1", "lf1", "l1", CapKindString, LabF1, "label on function key f1 if not f1", CapCategoryStandard, ""},
+	{"lab_f10", "lf10", "la", CapKindString, LabF10, "label on function key f10 if not f10", CapCategoryStandard, ""},
+	{"lab_f2", "lf2", "l2", CapKindString, LabF2, "label on function key f2 if not f2", CapCategoryStandard, ""},
+	{"lab_f3", "lf3", "l3", CapKindString, LabF3, "label on function key f3 if not f3", CapCategoryStandard, ""},
+	{"lab_f4", "lf4", "l4", CapKindString, LabF4, "label on function key f4 if not f4", CapCategoryStandard, ""},
+	{"lab_f5", "lf5", "l5", CapKindString, LabF5, "label on function key f5 if not f5", CapCategoryStandard, ""},
+	{"lab_f6", "lf6", "l6", CapKindString, LabF6, "label on function key f6 if not f6", CapCategoryStandard, ""},
+	{"lab_f7", "lf7", "l7", CapKindString, LabF7, "label on function key f7 if not f7", CapCategoryStandard, ""},
+	{"lab_f8", "lf8", "l8", CapKindString, LabF8, "label on function key f8 if not f8", CapCategoryStandard, ""},
+	{"lab_f9", "lf9", "l9", CapKindString, LabF9, "label on function key f9 if not f9", CapCategoryStandard, ""},
+	{"meta_off", "rmm", "mo", CapKindString, MetaOff, "turn off meta mode", CapCategoryStandard, ""},
+	{"meta_on", "smm", "mm", CapKindString, MetaOn, "turn on meta mode (8th-bit on)", CapCategoryStandard, ""},
+	{"newline", "nel", "nw", CapKindString, Newline, "newline (behave like cr followed by lf)", CapCategoryStandard, ""},
+	{"pad_char", "pad", "pc", CapKindString, PadChar, "padding char (instead of null)", CapCategoryStandard, ""},
+	{"parm_dch", "dch", "DC", CapKindString, ParmDch, "delete #1 characters (P*)", CapCategoryStandard, ""},
+	{"parm_delete_line", "dl", "DL", CapKindString, ParmDeleteLine, "delete #1 lines (P*)", CapCategoryStandard, ""},
+	{"parm_down_cursor", "cud", "DO", CapKindString, ParmDownCursor, "down #1 lines (P*)", CapCategoryStandard, ""},
+	{"parm_ich", "ich", "IC", CapKindString, ParmIch, "insert #1 characters (P*)", CapCategoryStandard, ""},
+	{"parm_index", "indn", "SF", CapKindString, ParmIndex, "scroll forward #1 lines (P)", CapCategoryStandard, ""},
+	{"parm_insert_line", "il", "AL", CapKindString, ParmInsertLine, "insert #1 lines (P*)", CapCategoryStandard, ""},
+	{"parm_left_cursor", "cub", "LE", CapKindString, ParmLeftCursor, "move #1 characters to the left (P)", CapCategoryStandard, ""},
+	{"parm_right_cursor", "cuf", "RI", CapKindString, ParmRightCursor, "move #1 characters to the right (P*)", CapCategoryStandard, ""},
+	{"parm_rindex", "rin", "SR", CapKindString, ParmRindex, "scroll back #1 lines (P)", CapCategoryStandard, ""},
+	{"parm_up_cursor", "cuu", "UP", CapKindString, ParmUpCursor, "up #1 lines (P*)", CapCategoryStandard, ""},
+	{"pkey_key", "pfkey", "pk", CapKindString, PkeyKey, "program function key #1 to type string #2", CapCategoryStandard, ""},
+	{"pkey_local", "pfloc", "pl", CapKindString, PkeyLocal, "program function key #1 to execute string #2", CapCategoryStandard, ""},
+	{"pkey_xmit", "pfx", "px", CapKindString, PkeyXmit, "program function key #1 to transmit string #2", CapCategoryStandard, ""},
+	{"print_screen", "mc0", "ps", CapKindString, PrintScreen, "print contents of screen", CapCategoryStandard, ""},
+	{"prtr_off", "mc4", "pf", CapKindString, PrtrOff, "turn off printer", CapCategoryStandard, ""},
+	{"prtr_on", "mc5", "po", CapKindString, PrtrOn, "turn on printer", CapCategoryStandard, ""},
+	{"repeat_char", "rep", "rp", CapKindString, RepeatChar, "repeat char #1 #2 times (P*)", CapCategoryStandard, ""},
+	{"reset_1string", "rs1", "r1", CapKindString, Reset1string, "reset string", CapCategoryStandard, ""},
+	{"reset_2string", "rs2", "r2", CapKindString, Reset2string, "reset string", CapCategoryStandard, ""},
+	{"reset_3string", "rs3", "r3", CapKindString, Reset3string, "reset string", CapCategoryStandard, ""},
+	{"reset_file", "rf", "rf", CapKindString, ResetFile, "name of reset file", CapCategoryStandard, ""},
+	{"restore_cursor", "rc", "rc", CapKindString, RestoreCursor, "restore cursor to position of last save_cursor", CapCategoryStandard, ""},
+	{"row_address", "vpa", "cv", CapKindString, RowAddress, "vertical position #1 absolute (P)", CapCategoryStandard, ""},
+	{"save_cursor", "sc", "sc", CapKindString, SaveCursor, "save current cursor position (P)", CapCategoryStandard, ""},
+	{"scroll_forward", "ind", "sf", CapKindString, ScrollForward, "scroll text up (P)", CapCategoryStandard, ""},
+	{"scroll_reverse", "ri", "sr", CapKindString, ScrollReverse, "scroll text down (P)", CapCategoryStandard, ""},
+	{"set_attributes", "sgr", "sa", CapKindString, SetAttributes, "define video attributes #1-#9 (PG9)", CapCategoryStandard, ""},
+	{"set_tab", "hts", "st", CapKindString, SetTab, "set a tab in every row, current columns", CapCategoryStandard, ""},
+	{"set_window", "wind", "wi", CapKindString, SetWindow, "current window is lines #1-#2 cols #3-#4", CapCategoryStandard, ""},
+	{"tab", "ht", "ta", CapKindString, Tab, "tab to next 8-space hardware tab stop", CapCategoryStandard, ""},
+	{"to_status_line", "tsl", "ts", CapKindString, ToStatusLine, "move to status line, column #1", CapCategoryStandard, ""},
+	{"underline_char", "uc", "uc", CapKindString, UnderlineChar, "underline char and move past it", CapCategoryStandard, ""},
+	{"up_half_line", "hu", "hu", CapKindString, UpHalfLine, "half a line up", CapCategoryStandard, ""},
+	{"init_prog", "iprog", "iP", CapKindString, InitProg, "path name of program for initialization", CapCategoryStandard, ""},
+	{"key_a1", "ka1", "K1", CapKindString, KeyA1, "upper left of keypad", CapCategoryKey, "KEY_A1"},
+	{"key_a3", "ka3", "K3", CapKindString, KeyA3, "upper right of keypad", CapCategoryKey, "KEY_A3"},
+	{"key_b2", "kb2", "K2", CapKindString, KeyB2, "center of keypad", CapCategoryKey, "KEY_B2"},
+	{"key_c1", "kc1", "K4", CapKindString, KeyC1, "lower left of keypad", CapCategoryKey, "KEY_C1"},
+	{"key_c3", "kc3", "K5", CapKindString, KeyC3, "lower right of keypad", CapCategoryKey, "KEY_C3"},
+	{"prtr_non", "mc5p", "pO", CapKindString, PrtrNon, "turn on printer for #1 bytes", CapCategoryStandard, ""},
+	{"char_padding", "rmp", "rP", CapKindString, CharPadding, "like ip but when in insert mode", CapCategoryStandard, ""},
+	{"acs_chars", "acsc", "ac", CapKindString, AcsChars, "graphics charset pairs, based on vt100", CapCategoryStandard, ""},
+	{"plab_norm", "pln", "pn", CapKindString, PlabNorm, "program label #1 to show string #2", CapCategoryStandard, ""},
+	{"key_btab", "kcbt", "kB", CapKindString, KeyBtab, "back-tab key", CapCategoryKey, "KEY_BTAB"},
+	{"enter_xon_mode", "smxon", "SX", CapKindString, EnterXonMode, "turn on xon/xoff handshaking", CapCategoryStandard, ""},
+	{"exit_xon_mode", "rmxon", "RX", CapKindString, ExitXonMode, "turn off xon/xoff handshaking", CapCategoryStandard, ""},
+	{"enter_am_mode", "smam", "SA", CapKindString, EnterAmMode, "turn on automatic margins", CapCategoryStandard, ""},
+	{"exit_am_mode", "rmam", "RA", CapKindString, ExitAmMode, "turn off automatic margins", CapCategoryStandard, ""},
+	{"xon_character", "xonc", "XN", CapKindString, XonCharacter, "XON character", CapCategoryStandard, ""},
+	{"xoff_character", "xoffc", "XF", CapKindString, XoffCharacter, "XOFF character", CapCategoryStandard, ""},
+	{"ena_acs", "enacs", "eA", CapKindString, EnaAcs, "enable alternate char set", CapCategoryStandard, ""},
+	{"label_on", "smln", "LO", CapKindString, LabelOn, "turn on soft labels", CapCategoryStandard, ""},
+	{"label_off", "rmln", "LF", CapKindString, LabelOff, "turn off soft labels", CapCategoryStandard, ""},
+	{"key_beg", "kbeg", "@1", CapKindString, KeyBeg, "begin key", CapCategoryKey, "KEY_BEG"},
+	{"key_cancel", "kcan", "@2", CapKindString, KeyCancel, "cancel key", CapCategoryKey, "KEY_CANCEL"},
+	{"key_close", "kclo", "@3", CapKindString, KeyClose, "close key", CapCategoryKey, "KEY_CLOSE"},
+	{"key_command", "kcmd", "@4", CapKindString, KeyCommand, "command key", CapCategoryKey, "KEY_COMMAND"},
+	{"key_copy", "kcpy", "@5", CapKindString, KeyCopy, "copy key", CapCategoryKey, "KEY_COPY"},
+	{"key_create", "kcrt", "@6", CapKindString, KeyCreate, "create key", CapCategoryKey, "KEY_CREATE"},
+	{"key_end", "kend", "@7", CapKindString, KeyEnd, "end key", CapCategoryKey, "KEY_END"},
+	{"key_enter", "kent", "@8", CapKindString, KeyEnter, "enter/send key", CapCategoryKey, "KEY_ENTER"},
+	{"key_exit", "kext", "@9", CapKindString, KeyExit, "exit key", CapCategoryKey, "KEY_EXIT"},
+	{"key_find", "kfnd", "@0", CapKindString, KeyFind, "find key", CapCategoryKey, "KEY_FIND"},
+	{"key_help", "khlp", "%1", CapKindString, KeyHelp, "help key", CapCategoryKey, "KEY_HELP"},
+	{"key_mark", "kmrk", "%2", CapKindString, KeyMark, "mark key", CapCategoryKey, "KEY_MARK"},
+	{"key_message", "kmsg", "%3", CapKindString, KeyMessage, "message key", CapCategoryKey, "KEY_MESSAGE"},
+	{"key_move", "kmov", "%4", CapKindString, KeyMove, "move key", CapCategoryKey, "KEY_MOVE"},
+	{"key_next", "knxt", "%5", CapKindString, KeyNext, "next key", CapCategoryKey, "KEY_NEXT"},
+	{"key_open", "kopn", "%6", CapKindString, KeyOpen, "open key", CapCategoryKey, "KEY_OPEN"},
+	{"key_options", "kopt", "%7", CapKindString, KeyOptions, "options key", CapCategoryKey, "KEY_OPTIONS"},
+	{"key_previous", "kprv", "%8", CapKindString, KeyPrevious, "previous key", CapCategoryKey, "KEY_PREVIOUS"},
+	{"key_print", "kprt", "%9", CapKindString, KeyPrint, "print key", CapCategoryKey, "KEY_PRINT"},
+	{"key_redo", "krdo", "%0", CapKindString, KeyRedo, "redo key", CapCategoryKey, "KEY_REDO"},
+	{"key_reference", "kref", "&1", CapKindString, KeyReference, "reference key", CapCategoryKey, "KEY_REFERENCE"},
+	{"key_refresh", "krfr", "&2", CapKindString, KeyRefresh, "refresh key", CapCategoryKey, "KEY_REFRESH"},
+	{"key_replace", "krpl", "&3", CapKindString, KeyReplace, "replace key", CapCategoryKey, "KEY_REPLACE"},
+	{"key_restart", "krst", "&4", CapKindString, KeyRestart, "restart key", CapCategoryKey, "KEY_RESTART"},
+	{"key_resume", "kres", "&5", CapKindString, KeyResume, "resume key", CapCategoryKey, "KEY_RESUME"},
+	{"key_save", "ksav", "&6", CapKindString, KeySave, "save key", CapCategoryKey, "KEY_SAVE"},
+	{"key_suspend", "kspd", "&7", CapKindString, KeySuspend, "suspend key", CapCategoryKey, "KEY_SUSPEND"},
+	{"key_undo", "kund", "&8", CapKindString, KeyUndo, "undo key", CapCategoryKey, "KEY_UNDO"},
+	{"key_sbeg", "kBEG", "&9", CapKindString, KeySbeg, "shifted begin key", CapCategoryKey, "KEY_SBEG"},
+	{"key_scancel", "kCAN", "&0", CapKindString, KeyScancel, "shifted cancel key", CapCategoryKey, "KEY_SCANCEL"},
+	{"key_scommand", "kCMD", "*1", CapKindString, KeyScommand, "shifted command key", CapCategoryKey, "KEY_SCOMMAND"},
+	{"key_scopy", "kCPY", "*2", CapKindString, KeyScopy, "shifted copy key", CapCategoryKey, "KEY_SCOPY"},
+	{"key_screate", "kCRT", "*3", CapKindString, KeyScreate, "shifted create key", CapCategoryKey, "KEY_SCREATE"},
+	{"key_sdc", "kDC", "*4", CapKindString, KeySdc, "shifted delete-character key", CapCategoryKey, "KEY_SDC"},
+	{"key_sdl", "kDL", "*5", CapKindString, KeySdl, "shifted delete-line key", CapCategoryKey, "KEY_SDL"},
+	{"key_select", "kslt", "*6", CapKindString, KeySelect, "select key", CapCategoryKey, "KEY_SELECT"},
+	{"key_send", "kEND", "*7", CapKindString, KeySend, "shifted end key", CapCategoryKey, "KEY_SEND"},
+	{"key_seol", "kEOL", "*8", CapKindString, KeySeol, "shifted clear-to-end-of-line key", CapCategoryKey, "KEY_SEOL"},
+	{"key_sexit", "kEXT", "*9", CapKindString, KeySexit, "shifted exit key", CapCategoryKey, "KEY_SEXIT"},
+	{"key_sfind", "kFND", "*0", CapKindString, KeySfind, "shifted find key", CapCategoryKey, "KEY_SFIND"},
+	{"key_shelp", "kHLP", "#1", CapKindString, KeyShelp, "shifted help key", CapCategoryKey, "KEY_SHELP"},
+	{"key_shome", "kHOM", "#2", CapKindString, KeyShome, "shifted home key", CapCategoryKey, "KEY_SHOME"},
+	{"key_sic", "kIC", "#3", CapKindString, KeySic, "shifted insert-character key", CapCategoryKey, "KEY_SIC"},
+	{"key_sleft", "kLFT", "#4", CapKindString, KeySleft, "shifted left-arrow key", CapCategoryKey, "KEY_SLEFT"},
+	{"key_smessage", "kMSG", "%a", CapKindString, KeySmessage, "shifted message key", CapCategoryKey, "KEY_SMESSAGE"},
+	{"key_smove", "kMOV", "%b", CapKindString, KeySmove, "shifted move key", CapCategoryKey, "KEY_SMOVE"},
+	{"key_snext", "kNXT", "%c", CapKindString, KeySnext, "shifted next key", CapCategoryKey, "KEY_SNEXT"},
+	{"key_soptions", "kOPT", "%d", CapKindString, KeySoptions, "shifted options key", CapCategoryKey, "KEY_SOPTIONS"},
+	{"key_sprevious", "kPRV", "%e", CapKindString, KeySprevious, "shifted previous key", CapCategoryKey, "KEY_SPREVIOUS"},
+	{"key_sprint", "kPRT", "%f", CapKindString, KeySprint, "shifted print key", CapCategoryKey, "KEY_SPRINT"},
+	{"key_sredo", "kRDO", "%g", CapKindString, KeySredo, "shifted redo key", CapCategoryKey, "KEY_SREDO"},
+	{"key_sreplace", "kRPL", "%h", CapKindString, KeySreplace, "shifted replace key", CapCategoryKey, "KEY_SREPLACE"},
+	{"key_sright", "kRIT", "%i", CapKindString, KeySright, "shifted right-arrow key", CapCategoryKey, "KEY_SRIGHT"},
+	{"key_srsume", "kRES", "%j", CapKindString, KeySrsume, "shifted resume key", CapCategoryKey, "KEY_SRSUME"},
+	{"key_ssave", "kSAV", "!1", CapKindString, KeySsave, "shifted save key", CapCategoryKey, "KEY_SSAVE"},
+	{"key_ssuspend", "kSPD", "!2", CapKindString, KeySsuspend, "shifted suspend key", CapCategoryKey, "KEY_SSUSPEND"},
+	{"key_sundo", "kUND", "!3", CapKindString, KeySundo, "shifted undo key", CapCategoryKey, "KEY_SUNDO"},
+	{"req_for_input", "rfi", "RF", CapKindString, ReqForInput, "send next input char (for ptys)", CapCategoryStandard, ""},
+	{"key_f11", "kf11", "F1", CapKindString, KeyF11, "F11 function key", CapCategoryKey, "KEY_F(11)"},
+	{"key_f12", "kf12", "F2", CapKindString, KeyF12, "F12 function key", CapCategoryKey, "KEY_F(12)"},
+	{"key_f13", "kf13", "F3", CapKindString, KeyF13, "F13 function key", CapCategoryKey, "KEY_F(13)"},
+	{"key_f14", "kf14", "F4", CapKindString, KeyF14, "F14 function key", CapCategoryKey, "KEY_F(14)"},
+	{"key_f15", "kf15", "F5", CapKindString, KeyF15, "F15 function key", CapCategoryKey, "KEY_F(15)"},
+	{"key_f16", "kf16", "F6", CapKindString, KeyF16, "F16 function key", CapCategoryKey, "KEY_F(16)"},
+	{"key_f17", "kf17", "F7", CapKindString, KeyF17, "F17 function key", CapCategoryKey, "KEY_F(17)"},
+	{"key_f18", "kf18", "F8", CapKindString, KeyF18, "F18 function key", CapCategoryKey, "KEY_F(18)"},
+	{"key_f19", "kf19", "F9", CapKindString, KeyF19, "F19 function key", CapCategoryKey, "KEY_F(19)"},
+	{"key_f20", "kf20", "FA", CapKindString, KeyF20, "F20 function key", CapCategoryKey, "KEY_F(20)"},
+	{"key_f21", "kf21", "FB", CapKindString, KeyF21, "F21 function key", CapCategoryKey, "KEY_F(21)"},
+	{"key_f22", "kf22", "FC", CapKindString, KeyF22, "F22 function key", CapCategoryKey, "KEY_F(22)"},
+	{"key_f23", "kf23", "FD", CapKindString, KeyF23, "F23 function key", CapCategoryKey, "KEY_F(23)"},
+	{"key_f24", "kf24", "FE", CapKindString, KeyF24, "F24 function key", CapCategoryKey, "KEY_F(24)"},
+	{"key_f25", "kf25", "FF", CapKindString, KeyF25, "F25 function key", CapCategoryKey, "KEY_F(25)"},
+	{"key_f26", "kf26", "FG", CapKindString, KeyF26, "F26 function key", CapCategoryKey, "KEY_F(26)"},
+	{"key_f27", "kf27", "FH", CapKindString, KeyF27, "F27 function key", CapCategoryKey, "KEY_F(27)"},
+	{"key_f28", "kf28", "FI", CapKindString, KeyF28, "F28 function key", CapCategoryKey, "KEY_F(28)"},
+	{"key_f29", "kf29", "FJ", CapKindString, KeyF29, "F29 function key", CapCategoryKey, "KEY_F(29)"},
+	{"key_f30", "kf30", "FK", CapKindString, KeyF30, "F30 function key", CapCategoryKey, "KEY_F(30)"},
+	{"key_f31", "kf31", "FL", CapKindString, KeyF31, "F31 function key", CapCategoryKey, "KEY_F(31)"},
+	{"key_f32", "kf32", "FM", CapKindString, KeyF32, "F32 function key", CapCategoryKey, "KEY_F(32)"},
+	{"key_f33", "kf33", "FN", CapKindString, KeyF33, "F33 function key", CapCategoryKey, "KEY_F(33)"},
+	{"key_f34", "kf34", "FO", CapKindString, KeyF34, "F34 function key", CapCategoryKey, "KEY_F(34)"},
+	{"key_f35", "kf35", "FP", CapKindString, KeyF35, "F35 function key", CapCategoryKey, "KEY_F(35)"},
+	{"key_f36", "kf36", "FQ", CapKindString, KeyF36, "F36 function key", CapCategoryKey, "KEY_F(36)"},
+	{"key_f37", "kf37", "FR", CapKindString, KeyF37, "F37 function key", CapCategoryKey, "KEY_F(37)"},
+	{"key_f38", "kf38", "FS", CapKindString, KeyF38, "F38 function key", CapCategoryKey, "KEY_F(38)"},
+	{"key_f39", "kf39", "FT", CapKindString, KeyF39, "F39 function key", CapCategoryKey, "KEY_F(39)"},
+	{"key_f40", "kf40", "FU", CapKindString, KeyF40, "F40 function key", CapCategoryKey, "KEY_F(40)"},
+	{"key_f41", "kf41", "FV", CapKindString, KeyF41, "F41 function key", CapCategoryKey, "KEY_F(41)"},
+	{"key_f42", "kf42", "FW", CapKindString, KeyF42, "F42 function key", CapCategoryKey, "KEY_F(42)"},
+	{"key_f43", "kf43", "FX", CapKindString, KeyF43, "F43 function key", CapCategoryKey, "KEY_F(43)"},
+	{"key_f44", "kf44", "FY", CapKindString, KeyF44, "F44 function key", CapCategoryKey, "KEY_F(44)"},
+	{"key_f45", "kf45", "FZ", CapKindString, KeyF45, "F45 function key", CapCategoryKey, "KEY_F(45)"},
+	{"key_f46", "kf46", "Fa", CapKindString, KeyF46, "F46 function key", CapCategoryKey, "KEY_F(46)"},
+	{"key_f47", "kf47", "Fb", CapKindString, KeyF47, "F47 function key", CapCategoryKey, "KEY_F(47)"},
+	{"key_f48", "kf48", "Fc", CapKindString, KeyF48, "F48 function key", CapCategoryKey, "KEY_F(48)"},
+	{"key_f49", "kf49", "Fd", CapKindString, KeyF49, "F49 function key", CapCategoryKey, "KEY_F(49)"},
+	{"key_f50", "kf50", "Fe", CapKindString, KeyF50, "F50 function key", CapCategoryKey, "KEY_F(50)"},
+	{"key_f51", "kf51", "Ff", CapKindString, KeyF51, "F51 function key", CapCategoryKey, "KEY_F(51)"},
+	{"key_f52", "kf52", "Fg", CapKindString, KeyF52, "F52 function key", CapCategoryKey, "KEY_F(52)"},
+	{"key_f53", "kf53", "Fh", CapKindString, KeyF53, "F53 function key", CapCategoryKey, "KEY_F(53)"},
+	{"key_f54", "kf54", "Fi", CapKindString, KeyF54, "F54 function key", CapCategoryKey, "KEY_F(54)"},
+	{"key_f55", "kf55", "Fj", CapKindString, KeyF55, "F55 function key", CapCategoryKey, "KEY_F(55)"},
+	{"key_f56", "kf56", "Fk", CapKindString, KeyF56, "F56 function key", CapCategoryKey, "KEY_F(56)"},
+	{"key_f57", "kf57", "Fl", CapKindString, KeyF57, "F57 function key", CapCategoryKey, "KEY_F(57)"},
+	{"key_f58", "kf58", "Fm", CapKindString, KeyF58, "F58 function key", CapCategoryKey, "KEY_F(58)"},
+	{"key_f59", "kf59", "Fn", CapKindString, KeyF59, "F59 function key", CapCategoryKey, "KEY_F(59)"},
+	{"key_f60", "kf60", "Fo", CapKindString, KeyF60, "F60 function key", CapCategoryKey, "KEY_F(60)"},
+	{"key_f61", "kf61", "Fp", CapKindString, KeyF61, "F61 function key", CapCategoryKey, "KEY_F(61)"},
+	{"key_f62", "kf62", "Fq", CapKindString, KeyF62, "F62 function key", CapCategoryKey, "KEY_F(62)"},
+	{"key_f63", "kf63", "Fr", CapKindString, KeyF63, "F63 function key", CapCategoryKey, "KEY_F(63)"},
+	{"clr_bol", "el1", "cb", CapKindString, ClrBol, "Clear to beginning of line", CapCategoryStandard, ""},
+	{"clear_margins", "mgc", "MC", CapKindString, ClearMargins, "clear right and left soft margins", CapCategoryStandard, ""},
+	{"set_left_margin", "smgl", "ML", CapKindString, SetLeftMargin, "set left soft margin at current column.\t See smgl. (ML is not in BSD termcap)", CapCategoryStandard, ""},
+	{"set_right_margin", "smgr", "MR", CapKindString, SetRightMargin, "set right soft margin at current column", CapCategoryStandard, ""},
+	{"label_format", "fln", "Lf", CapKindString, LabelFormat, "label format", CapCategoryStandard, ""},
+	{"set_clock", "sclk", "SC", CapKindString, SetClock, "set clock, #1 hrs #2 mins #3 secs", CapCategoryStandard, ""},
+	{"display_clock", "dclk", "DK", CapKindString, DisplayClock, "display clock", CapCategoryStandard, ""},
+	{"remove_clock", "rmclk", "RC", CapKindString, RemoveClock, "remove clock", CapCategoryStandard, ""},
+	{"create_window", "cwin", "CW", CapKindString, CreateWindow, "define a window #1 from #2,#3 to #4,#5", CapCategoryStandard, ""},
+	{"goto_window", "wingo", "WG", CapKindString, GotoWindow, "go to window #1", CapCategoryStandard, ""},
+	{"hangup", "hup", "HU", CapKindString, Hangup, "hang-up phone", CapCategoryStandard, ""},
+	{"dial_phone", "dial", "DI", CapKindString, DialPhone, "dial number #1", CapCategoryStandard, ""},
+	{"quick_dial", "qdial", "QD", CapKindString, QuickDial, "dial number #1 without checking", CapCategoryStandard, ""},
+	{"tone", "tone", "TO", CapKindString, Tone, "select touch tone dialing", CapCategoryStandard, ""},
+	{"pulse", "pulse", "PU", CapKindString, Pulse, "select pulse dialing", CapCategoryStandard, ""},
+	{"flash_hook", "hook", "fh", CapKindString, FlashHook, "flash switch hook", CapCategoryStandard, ""},
+	{"fixed_pause", "pause", "PA", CapKindString, FixedPause, "pause for 2-3 seconds", CapCategoryStandard, ""},
+	{"wait_tone", "wait", "WA", CapKindString, WaitTone, "wait for dial-tone", CapCategoryStandard, ""},
+	{"user0", "u0", "u0", CapKindString, User0, "User string #0", CapCategoryStandard, ""},
+	{"user1", "u1", "u1", CapKindString, User1, "User string #1", CapCategoryStandard, ""},
+	{"user2", "u2", "u2", CapKindString, User2, "User string #2", CapCategoryStandard, ""},
+	{"user3", "u3", "u3", CapKindString, User3, "User string #3", CapCategoryStandard, ""},
+	{"user4", "u4", "u4", CapKindString, User4, "User string #4", CapCategoryStandard, ""},
+	{"user5", "u5", "u5", CapKindString, User5, "User string #5", CapCategoryStandard, ""},
+	{"user6", "u6", "u6", CapKindString, User6, "User string #6", CapCategoryStandard, ""},
+	{"user7", "u7", "u7", CapKindString, User7, "User string #7", CapCategoryStandard, ""},
+	{"user8", "u8", "u8", CapKindString, User8, "User string #8", CapCategoryStandard, ""},
+	{"user9", "u9", "u9", CapKindString, User9, "User string #9", CapCategoryStandard, ""},
+	{"orig_pair", "op", "op", CapKindString, OrigPair, "Set default pair to its original value", CapCategoryStandard, ""},
+	{"orig_colors", "oc", "oc", CapKindString, OrigColors, "Set all color pairs to the original ones", CapCategoryStandard, ""},
+	{"initialize_color", "initc", "Ic", CapKindString, InitializeColor, "initialize color #1 to (#2,#3,#4)", CapCategoryStandard, ""},
+	{"initialize_pair", "initp", "Ip", CapKindString, InitializePair, "Initialize color pair #1 to fg=(#2,#3,#4), bg=(#5,#6,#7)", CapCategoryStandard, ""},
+	{"set_color_pair", "scp", "sp", CapKindString, SetColorPair, "Set current color pair to #1", CapCategoryStandard, ""},
+	{"set_foreground", "setf", "Sf", CapKindString, SetForeground, "Set foreground color #1", CapCategoryStandard, ""},
+	{"set_background", "setb", "Sb", CapKindString, SetBackground, "Set background color #1", CapCategoryStandard, ""},
+	{"change_char_pitch", "cpi", "ZA", CapKindString, ChangeCharPitch, "Change number of characters per inch to #1", CapCategoryStandard, ""},
+	{"change_line_pitch", "lpi", "ZB", CapKindString, ChangeLinePitch, "Change number of lines per inch to #1", CapCategoryStandard, ""},
+	{"change_res_horz", "chr", "ZC", CapKindString, ChangeResHorz, "Change horizontal resolution to #1", CapCategoryStandard, ""},
+	{"change_res_vert", "cvr", "ZD", CapKindString, ChangeResVert, "Change vertical resolution to #1", CapCategoryStandard, ""},
+	{"define_char", "defc", "ZE", CapKindString, DefineChar, "Define a character #1, #2 dots wide, descender #3", CapCategoryStandard, ""},
+	{"enter_doublewide_mode", "swidm", "ZF", CapKindString, EnterDoublewideMode, "Enter double-wide mode", CapCategoryStandard, ""},
+	{"enter_draft_quality", "sdrfq", "ZG", CapKindString, EnterDraftQuality, "Enter draft-quality mode", CapCategoryStandard, ""},
+	{"enter_italics_mode", "sitm", "ZH", CapKindString, EnterItalicsMode, "Enter italic mode", CapCategoryStandard, ""},
+	{"enter_leftward_mode", "slm", "ZI", CapKindString, EnterLeftwardMode, "Start leftward carriage motion", CapCategoryStandard, ""},
+	{"enter_micro_mode", "smicm", "ZJ", CapKindString, EnterMicroMode, "Start micro-motion mode", CapCategoryStandard, ""},
+	{"enter_near_letter_quality", "snlq", "ZK", CapKindString, EnterNearLetterQuality, "Enter NLQ mode", CapCategoryStandard, ""},
+	{"enter_normal_quality", "snrmq", "ZL", CapKindString, EnterNormalQuality, "Enter normal-quality mode", CapCategoryStandard, ""},
+	{"enter_shadow_mode", "sshm", "ZM", CapKindString, EnterShadowMode, "Enter shadow-print mode", CapCategoryStandard, ""},
+	{"enter_subscript_mode", "ssubm", "ZN", CapKindString, EnterSubscriptMode, "Enter subscript mode", CapCategoryStandard, ""},
+	{"enter_superscript_mode", "ssupm", "ZO", CapKindString, EnterSuperscriptMode, "Enter superscript mode", CapCategoryStandard, ""},
+	{"enter_upward_mode", "sum", "ZP", CapKindString, EnterUpwardMode, "Start upward carriage motion", CapCategoryStandard, ""},
+	{"exit_doublewide_mode", "rwidm", "ZQ", CapKindString, ExitDoublewideMode, "End double-wide mode", CapCategoryStandard, ""},
+	{"exit_italics_mode", "ritm", "ZR", CapKindString, ExitItalicsMode, "End italic mode", CapCategoryStandard, ""},
+	{"exit_leftward_mode", "rlm", "ZS", CapKindString, ExitLeftwardMode, "End left-motion mode", CapCategoryStandard, ""},
+	{"exit_micro_mode", "rmicm", "ZT", CapKindString, ExitMicroMode, "End micro-motion mode", CapCategoryStandard, ""},
+	{"exit_shadow_mode", "rshm", "ZU", CapKindString, ExitShadowMode, "End shadow-print mode", CapCategoryStandard, ""},
+	{"exit_subscript_mode", "rsubm", "ZV", CapKindString, ExitSubscriptMode, "End subscript mode", CapCategoryStandard, ""},
+	{"exit_superscript_mode", "rsupm", "ZW", CapKindString, ExitSuperscriptMode, "End superscript mode", CapCategoryStandard, ""},
+	{"exit_upward_mode", "rum", "ZX", CapKindString, ExitUpwardMode, "End reverse character motion", CapCategoryStandard, ""},
+	{"micro_column_address", "mhpa", "ZY", CapKindString, MicroColumnAddress, "Like column_address in micro mode", CapCategoryStandard, ""},
+	{"micro_down", "mcud1", "ZZ", CapKindString, MicroDown, "Like cursor_down in micro mode", CapCategoryStandard, ""},
+	{"micro_left", "mcub1", "Za", CapKindString, MicroLeft, "Like cursor_left in micro mode", CapCategoryStandard, ""},
+	{"micro_right", "mcuf1", "Zb", CapKindString, MicroRight, "Like cursor_right in micro mode", CapCategoryStandard, ""},
+	{"micro_row_address", "mvpa", "Zc", CapKindString, MicroRowAddress, "Like row_address #1 in micro mode", CapCategoryStandard, ""},
+	{"micro_up", "mcuu1", "Zd", CapKindString, MicroUp, "Like cursor_up in micro mode", CapCategoryStandard, ""},
+	{"order_of_pins", "porder", "Ze", CapKindString, OrderOfPins, "Match software bits to print-head pins", CapCategoryStandard, ""},
+	{"parm_down_micro", "mcud", "Zf", CapKindString, ParmDownMicro, "Like parm_down_cursor in micro mode", CapCategoryStandard, ""},
+	{"parm_left_micro", "mcub", "Zg", CapKindString, ParmLeftMicro, "Like parm_left_cursor in micro mode", CapCategoryStandard, ""},
+	{"parm_right_micro", "mcuf", "Zh", CapKindString, ParmRightMicro, "Like parm_right_cursor in micro mode", CapCategoryStandard, ""},
+	{"parm_up_micro", "mcuu", "Zi", CapKindString, ParmUpMicro, "Like parm_up_cursor in micro mode", CapCategoryStandard, ""},
+	{"select_char_set", "scs", "Zj", CapKindString, SelectCharSet, "Select character set, #1", CapCategoryStandard, ""},
+	{"set_bottom_margin", "smgb", "Zk", CapKindString, SetBottomMargin, "Set bottom margin at current line", CapCategoryStandard, ""},
+	{"set_bottom_margin_parm", "smgbp", "Zl", CapKindString, SetBottomMarginParm, "Set bottom margin at line #1 or (if smgtp is not given) #2 lines from bottom", CapCategoryStandard, ""},
+	{"set_left_margin_parm", "smglp", "Zm", CapKindString, SetLeftMarginParm, "Set left (right) margin at column #1", CapCategoryStandard, ""},
+	{"set_right_margin_parm", "smgrp", "Zn", CapKindString, SetRightMarginParm, "Set right margin at column #1", CapCategoryStandard, ""},
+	{"set_top_margin", "smgt", "Zo", CapKindString, SetTopMargin, "Set top margin at current line", CapCategoryStandard, ""},
+	{"set_top_margin_parm", "smgtp", "Zp", CapKindString, SetTopMarginParm, "Set top (bottom) margin at row #1", CapCategoryStandard, ""},
+	{"start_bit_image", "sbim", "Zq", CapKindString, StartBitImage, "Start printing bit image graphics", CapCategoryStandard, ""},
+	{"start_char_set_def", "scsd", "Zr", CapKindString, StartCharSetDef, "Start character set definition #1, with #2 characters in the set", CapCategoryStandard, ""},
+	{"stop_bit_image", "rbim", "Zs", CapKindString, StopBitImage, "Stop printing bit image graphics", CapCategoryStandard, ""},
+	{"stop_char_set_def", "rcsd", "Zt", CapKindString, StopCharSetDef, "End definition of character set #1", CapCategoryStandard, ""},
+	{"subscript_characters", "subcs", "Zu", CapKindString, SubscriptCharacters, "List of subscriptable characters", CapCategoryStandard, ""},
+	{"superscript_characters", "supcs", "Zv", CapKindString, SuperscriptCharacters, "List of superscriptable characters", CapCategoryStandard, ""},
+	{"these_cause_cr", "docr", "Zw", CapKindString, TheseCauseCr, "Printing any of these characters causes CR", CapCategoryStandard, ""},
+	{"zero_motion", "zerom", "Zx", CapKindString, ZeroMotion, "No motion for subsequent character", CapCategoryStandard, ""},
+	{"char_set_names", "csnm", "Zy", CapKindString, CharSetNames, "Produce #1'th item from list of character set names", CapCategoryStandard, ""},
+	{"key_mouse", "kmous", "Km", CapKindString, KeyMouse, "Mouse event has occurred", CapCategoryKey, "KEY_MOUSE"},
+	{"mouse_info", "minfo", "Mi", CapKindString, MouseInfo, "Mouse status information", CapCategoryStandard, ""},
+	{"req_mouse_pos", "reqmp", "RQ", CapKindString, ReqMousePos, "Request mouse position", CapCategoryStandard, ""},
+	{"get_mouse", "getm", "Gm", CapKindString, GetMouse, "Curses should get button events, parameter #1 not documented", CapCategoryStandard, ""},
+	{"set_a_foreground", "setaf", "AF", CapKindString, SetAForeground, "Set foreground color to #1, using ANSI escape", CapCategoryStandard, ""},
+	{"set_a_background", "setab", "AB", CapKindString, SetABackground, "Set background color to #1, using ANSI escape", CapCategoryStandard, ""},
+	{"pkey_plab", "pfxl", "xl", CapKindString, PkeyPlab, "Program function key #1 to type string #2 and show string #3", CapCategoryStandard, ""},
+	{"device_type", "devt", "dv", CapKindString, DeviceType, "Indicate language/codeset support", CapCategoryStandard, ""},
+	{"code_set_init", "csin", "ci", CapKindString, CodeSetInit, "Init sequence for multiple codesets", CapCategoryStandard, ""},
+	{"set0_des_seq", "s0ds", "s0", CapKindString, Set0DesSeq, "Shift to codeset 0 (EUC set 0, ASCII)", CapCategoryStandard, ""},
+	{"set1_des_seq", "s1ds", "s1", CapKindString, Set1DesSeq, "Shift to codeset 1", CapCategoryStandard, ""},
+	{"set2_des_seq", "s2ds", "s2", CapKindString, Set2DesSeq, "Shift to codeset 2", CapCategoryStandard, ""},
+	{"set3_des_seq", "s3ds", "s3", CapKindString, Set3DesSeq, "Shift to codeset 3", CapCategoryStandard, ""},
+	{"set_lr_margin", "smglr", "ML", CapKindString, SetLrMargin, "Set both left and right margins to #1, #2.  (ML is not in BSD termcap)", CapCategoryStandard, ""},
+	{"set_tb_margin", "smgtb", "MT", CapKindString, SetTbMargin, "Sets both top and bottom margins to #1, #2", CapCategoryStandard, ""},
+	{"bit_image_repeat", "birep", "Xy", CapKindString, BitImageRepeat, "Repeat bit image cell #1 #2 times", CapCategoryStandard, ""},
+	{"bit_image_newline", "binel", "Zz", CapKindString, BitImageNewline, "Move to next row of the bit image", CapCategoryStandard, ""},
+	{"bit_image_carriage_return", "bicr", "Yv", CapKindString, BitImageCarriageReturn, "Move to beginning of same row", CapCategoryStandard, ""},
+	{"color_names", "colornm", "Yw", CapKindString, ColorNames, "Give name for color #1", CapCategoryStandard, ""},
+	{"define_bit_image_region", "defbi", "Yx", CapKindString, DefineBitImageRegion, "Define rectangular bit image region", CapCategoryStandard, ""},
+	{"end_bit_image_region", "endbi", "Yy", CapKindString, EndBitImageRegion, "End a bit-image region", CapCategoryStandard, ""},
+	{"set_color_band", "setcolor", "Yz", CapKindString, SetColorBand, "Change to ribbon color #1", CapCategoryStandard, ""},
+	{"set_page_length", "slines", "YZ", CapKindString, SetPageLength, "Set page length to #1 lines", CapCategoryStandard, ""},
+	{"display_pc_char", "dispc", "S1", CapKindString, DisplayPcChar, "Display PC character #1", CapCategoryStandard, ""},
+	{"enter_pc_charset_mode", "smpch", "S2", CapKindString, EnterPcCharsetMode, "Enter PC character display mode", CapCategoryStandard, ""},
+	{"exit_pc_charset_mode", "rmpch", "S3", CapKindString, ExitPcCharsetMode, "Exit PC character display mode", CapCategoryStandard, ""},
+	{"enter_scancode_mode", "smsc", "S4", CapKindString, EnterScancodeMode, "Enter PC scancode mode", CapCategoryStandard, ""},
+	{"exit_scancode_mode", "rmsc", "S5", CapKindString, ExitScancodeMode, "Exit PC scancode mode", CapCategoryStandard, ""},
+	{"pc_term_options", "pctrm", "S6", CapKindString, PcTermOptions, "PC terminal options", CapCategoryStandard, ""},
+	{"scancode_escape", "scesc", "S7", CapKindString, ScancodeEscape, "Escape for scancode emulation", CapCategoryStandard, ""},
+	{"alt_scancode_esc", "scesa", "S8", CapKindString, AltScancodeEsc, "Alternate escape for scancode emulation", CapCategoryStandard, ""},
+	{"enter_horizontal_hl_mode", "ehhlm", "Xh", CapKindString, EnterHorizontalHlMode, "Enter horizontal highlight mode", CapCategoryStandard, ""},
+	{"enter_left_hl_mode", "elhlm", "Xl", CapKindString, EnterLeftHlMode, "Enter left highlight mode", CapCategoryStandard, ""},
+	{"enter_low_hl_mode", "elohlm", "Xo", CapKindString, EnterLowHlMode, "Enter low highlight mode", CapCategoryStandard, ""},
+	{"enter_right_hl_mode", "erhlm", "Xr", CapKindString, EnterRightHlMode, "Enter right highlight mode", CapCategoryStandard, ""},
+	{"enter_top_hl_mode", "ethlm", "Xt", CapKindString, EnterTopHlMode, "Enter top highlight mode", CapCategoryStandard, ""},
+	{"enter_vertical_hl_mode", "evhlm", "Xv", CapKindString, EnterVerticalHlMode, "Enter vertical highlight mode", CapCategoryStandard, ""},
+	{"set_a_attributes", "sgr1", "sA", CapKindString, SetAAttributes, "Define second set of video attributes #1-#6", CapCategoryStandard, ""},
+	{"set_pglen_inch", "slength", "YI", CapKindString, SetPglenInch, "Set page length to #1 hundredth of an inch (some implementations use sL for termcap)", CapCategoryStandard, ""},
+	{"termcap_init2", "OTi2", "i2", CapKindString, TermcapInit2, "secondary initialization string", CapCategoryObsolete, ""},
+	{"termcap_reset", "OTrs", "rs", CapKindString, TermcapReset, "terminal reset string", CapCategoryObsolete, ""},
+	{"linefeed_if_not_lf", "OTnl", "nl", CapKindString, LinefeedIfNotLf, "use to move down", CapCategoryObsolete, ""},
+	{"backspace_if_not_bs", "OTbc", "bc", CapKindString, BackspaceIfNotBs, "move left, if not ^H", CapCategoryObsolete, ""},
+	{"other_non_function_keys", "OTko", "ko", CapKindString, OtherNonFunctionKeys, "list of self-mapped keycaps", CapCategoryObsolete, ""},
+	{"arrow_key_map", "OTma", "ma", CapKindString, ArrowKeyMap, "map motion-keys for vi version 2", CapCategoryObsolete, ""},
+	{"acs_ulcorner", "OTG2", "G2", CapKindString, AcsUlcorner, "single upper left", CapCategoryObsolete, ""},
+	{"acs_llcorner", "OTG3", "G3", CapKindString, AcsLlcorner, "single lower left", CapCategoryObsolete, ""},
+	{"acs_urcorner", "OTG1", "G1", CapKindString, AcsUrcorner, "single upper right", CapCategoryObsolete, ""},
+	{"acs_lrcorner", "OTG4", "G4", CapKindString, AcsLrcorner, "single lower right", CapCategoryObsolete, ""},
+	{"acs_ltee", "OTGR", "GR", CapKindString, AcsLtee, "tee pointing right", CapCategoryObsolete, ""},
+	{"acs_rtee", "OTGL", "GL", CapKindString, AcsRtee, "tee pointing left", CapCategoryObsolete, ""},
+	{"acs_btee", "OTGU", "GU", CapKindString, AcsBtee, "tee pointing up", CapCategoryObsolete, ""},
+	{"acs_ttee", "OTGD", "GD", CapKindString, AcsTtee, "tee pointing down", CapCategoryObsolete, ""},
+	{"acs_hline", "OTGH", "GH", CapKindString, AcsHline, "single horizontal line", CapCategoryObsolete, ""},
+	{"acs_vline", "OTGV", "GV", CapKindString, AcsVline, "single vertical line", CapCategoryObsolete, ""},
+	{"acs_plus", "OTGC", "GC", CapKindString, AcsPlus, "single intersection", CapCategoryObsolete, ""},
+	{"memory_lock", "meml", "ml", CapKindString, MemoryLock, "lock memory above cursor", CapCategoryExtension, ""},
+	{"memory_unlock", "memu", "mu", CapKindString, MemoryUnlock, "unlock memory", CapCategoryExtension, ""},
+	{"box_chars_1", "box1", "bx", CapKindString, BoxChars1, "box characters primary set", CapCategoryExtension, ""},
+}
+
+// capAliases are the termcap and terminfo capability aliases.
+var capAliases = [...]CapAlias{
+	{"sb", "scroll_reverse", "BSD", true},
+	{"BO", "enter_reverse_mode", "AT&T", true},
+	{"CI", "cursor_invisible", "AT&T", true},
+	{"CV", "cursor_normal", "AT&T", true},
+	{"DS", "enter_dim_mode", "AT&T", true},
+	{"FE", "label_off", "AT&T", true},
+	{"FL", "label_on", "AT&T", true},
+	{"XS", "enter_secure_mode", "AT&T", true},
+	{"EE", "enter_dim_mode", "XENIX", true},
+	{"GE", "exit_alt_charset_mode", "XENIX", true},
+	{"GS", "enter_alt_charset_mode", "XENIX", true},
+	{"CF", "cursor_invisible", "XENIX", true},
+	{"CO", "cursor_normal", "XENIX", true},
+	{"EN", "key_end", "XENIX", true},
+	{"HM", "key_home", "XENIX", true},
+	{"LD", "key_dl", "XENIX", true},
+	{"PD", "key_npage", "XENIX", true},
+	{"PN", "prtr_on", "XENIX", true},
+	{"PS", "prtr_off", "XENIX", true},
+	{"PU", "key_ppage", "XENIX", true},
+	{"RT", "key_enter", "XENIX", true},
+	{"UP", "key_up", "XENIX", true},
+	{"G6", "", "XENIX", true},
+	{"G7", "", "XENIX", true},
+	{"G5", "", "XENIX", true},
+	{"G8", "", "XENIX", true},
+	{"Gr", "", "XENIX", true},
+	{"Gu", "", "XENIX", true},
+	{"Gd", "", "XENIX", true},
+	{"Gh", "", "XENIX", true},
+	{"Gv", "", "XENIX", true},
+	{"Gc", "", "XENIX", true},
+	{"GG", "", "XENIX", true},
+	{"kq", "key_help", "IBM", true},
+	{"HS", "enter_dim_mode", "IRIS", true},
+	{"KA", "key_f10", "Tek", true},
+	{"KB", "key_f11", "Tek", true},
+	{"KC", "key_f12", "Tek", true},
+	{"KD", "key_f13", "Tek", true},
+	{"KE", "key_f14", "Tek", true},
+	{"KF", "key_f15", "Tek", true},
+	{"BC", "set_background", "Tek", true},
+	{"FC", "set_foreground", "Tek", true},
+	{"font0", "set0_des_seq", "IBM", false},
+	{"font1", "set1_des_seq", "IBM", false},
+	{"font2", "set2_des_seq", "IBM", false},
+	{"font3", "set3_des_seq", "IBM", false},
+	{"kbtab", "key_btab", "IBM", false},
+	{"ksel", "key_select", "IBM", false},
+}
@@ -1,5 +1,10 @@
 package terminfo
 
+import (
+	"math/bits"
+	"strings"
+)
+
 //go:generate go run gen.go
 
 // BoolCapName returns the bool capability name.
@@ -31,3 +36,287 @@ func StringCapName(i int) string {
 func StringCapNameShort(i int) string {
 	return stringCapNames[2*i+1]
 }
+
+// BoolCapNameTermcap returns the termcap bool capability name.
+func BoolCapNameTermcap(i int) string {
+	return boolCapTermcapNames[i]
+}
+
+// NumCapNameTermcap returns the termcap num capability name.
+func NumCapNameTermcap(i int) string {
+	return numCapTermcapNames[i]
+}
+
+// StringCapNameTermcap returns the termcap string capability name.
+func StringCapNameTermcap(i int) string {
+	return stringCapTermcapNames[i]
+}
+
+// CapKind is the kind of a capability.
+type CapKind uint
+
+// CapKind values.
+const (
+	CapKindBool CapKind = iota
+	CapKindNum
+	CapKindString
+	CapKindExtBool
+	CapKindExtNum
+	CapKindExtString
+)
+
+// String satisfies the Stringer interface.
+func (k CapKind) String() string {
+	switch k {
+	case CapKindBool:
+		return "bool"
+	case CapKindNum:
+		return "num"
+	case CapKindString:
+		return "string"
+	case CapKindExtBool:
+		return "extended bool"
+	case CapKindExtNum:
+		return "extended num"
+	case CapKindExtString:
+		return "extended string"
+	}
+	return "unknown"
+}
+
+// Extended determines if the kind is an extended capability kind.
+func (k CapKind) Extended() bool {
+	return k == CapKindExtBool || k == CapKindExtNum || k == CapKindExtString
+}
+
+// CapState is the state of a capability.
+type CapState uint
+
+// CapState values.
+const (
+	// CapAbsent is the state of a capability not defined by an entry.
+	CapAbsent CapState = iota
+
+	// CapPresent is the state of a capability defined by an entry.
+	CapPresent
+
+	// CapCancelled is the state of a capability explicitly cancelled (@) by
+	// an entry.
+	CapCancelled
+)
+
+// String satisfies the Stringer interface.
+func (s CapState) String() string {
+	switch s {
+	case CapPresent:
+		return "present"
+	case CapCancelled:
+		return "cancelled"
+	}
+	return "absent"
+}
+
+// capIndex is a capability kind and index.
+type capIndex struct {
+	kind  CapKind
+	index int
+}
+
+// LookupCap returns the kind and index of the capability with the long,
+// short or termcap name. Long and short names take precedence over termcap
+// names.
+func LookupCap(name string) (CapKind, int, bool) {
+	if c, ok := capNames[name]; ok {
+		return c.kind, c.index, true
+	}
+	if c, ok := termcapNames[name]; ok {
+		return c.kind, c.index, true
+	}
+	return 0, 0, false
+}
+
+// LookupCap returns the kind and index of the capability with the long,
+// short or termcap name, additionally searching the extended capability
+// names when name is not a standard capability. As extended capability names
+// are terminfo names, they take precedence over termcap names (for example,
+// the XF extended cap over the termcap name of xoffc).
+func (ti *Terminfo) LookupCap(name string) (CapKind, int, bool) {
+	if c, ok := capNames[name]; ok {
+		return c.kind, c.index, true
+	}
+	if kind, i, ok := ti.lookupExt(name); ok {
+		return kind, i, true
+	}
+	return LookupCap(name)
+}
+
+// CapCategory is the category of a capability.
+type CapCategory uint
+
+// CapCategory values.
+const (
+	// CapCategoryStandard is the category of the standard capabilities.
+	CapCategoryStandard CapCategory = iota
+
+	// CapCategoryKey is the category of the key capabilities.
+	CapCategoryKey
+
+	// CapCategoryObsolete is the category of the obsolete termcap
+	// capabilities.
+	CapCategoryObsolete
+
+	// CapCategoryExtension is the category of the ncurses extension
+	// capabilities.
+	CapCategoryExtension
+)
+
+// String satisfies the Stringer interface.
+func (c CapCategory) String() string {
+	switch c {
+	case CapCategoryKey:
+		return "key"
+	case CapCategoryObsolete:
+		return "obsolete"
+	case CapCategoryExtension:
+		return "extension"
+	}
+	return "standard"
+}
+
+// CapInfo describes a capability.
+type CapInfo struct {
+	// Name is the long name.
+	Name string
+
+	// Short is the short name.
+	Short string
+
+	// Termcap is the termcap name.
+	Termcap string
+
+	// Kind is the capability kind.
+	Kind CapKind
+
+	// Index is the capability index.
+	Index int
+
+	// Description is the description.
+	Description string
+
+	// Category is the capability category.
+	Category CapCategory
+
+	// KeyName is the curses key name (ie, KEY_DOWN) for key capabilities.
+	KeyName string
+}
+
+// CapAlias describes a capability alias.
+type CapAlias struct {
+	// Name is the alias name.
+	Name string
+
+	// Cap is the long name of the aliased capability, or empty when the
+	// alias is ignored.
+	Cap string
+
+	// Source is the system that defined the alias.
+	Source string
+
+	// Termcap indicates the alias is a termcap alias, otherwise it is a
+	// terminfo alias.
+	Termcap bool
+}
+
+// CapInfos returns the descriptions of all bool, num and string capabilities,
+// in index order.
+func CapInfos() []CapInfo {
+	return append([]CapInfo(nil), capInfos[:]...)
+}
+
+// CapAliases returns all termcap and terminfo capability aliases.
+func CapAliases() []CapAlias {
+	return append([]CapAlias(nil), capAliases[:]...)
+}
+
+// capInfo returns the description of the cap i of kind.
+func capInfo(kind CapKind, i int) CapInfo {
+	switch kind {
+	case CapKindNum:
+		i += CapCountBool
+	case CapKindString:
+		i += CapCountBool + CapCountNum
+	}
+	return capInfos[i]
+}
+
+// LookupCapInfo returns the description of the capability with the long,
+// short or termcap name. Aliases are resolved to the aliased capability.
+func LookupCapInfo(name string) (CapInfo, bool) {
+	if kind, i, ok := LookupCap(name); ok {
+		return capInfo(kind, i), true
+	}
+	for _, a := range capAliases {
+		if a.Name == name && a.Cap != "" {
+			return LookupCapInfo(a.Cap)
+		}
+	}
+	return CapInfo{}, false
+}
+
+// SearchCapInfos returns the descriptions of all capabilities whose names or
+// description contain s, ignoring case.
+func SearchCapInfos(s string) []CapInfo {
+	s = strings.ToLower(s)
+	var infos []CapInfo
+	for _, c := range capInfos {
+		if strings.Contains(c.Name, s) ||
+			strings.Contains(strings.ToLower(c.Short), s) ||
+			strings.Contains(strings.ToLower(c.Termcap), s) ||
+			strings.Contains(strings.ToLower(c.Description), s) {
+			infos = append(infos, c)
+		}
+	}
+	return infos
+}
+
+// capSetLen is the number of words needed to hold a set of any capability
+// kind.
+const capSetLen = (CapCountString + 63) / 64
+
+// CapSet is a set of capability indexes.
+type CapSet [capSetLen]uint64
+
+// Has determines if i is in the set.
+func (s CapSet) Has(i int) bool {
+	return i >= 0 && i < capSetLen*64 && s[i/64]&(1<<uint(i%64)) != 0
+}
+
+// Set adds i to the set.
+func (s *CapSet) Set(i int) {
+	s[i/64] |= 1 << uint(i%64)
+}
+
+// Unset removes i from the set.
+func (s *CapSet) Unset(i int) {
+	s[i/64] &^= 1 << uint(i%64)
+}
+
+// Len returns the number of indexes in the set.
+func (s CapSet) Len() int {
+	var n int
+	for _, w := range s {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// Indexes returns the indexes in the set, in order.
+func (s CapSet) Indexes() []int {
+	z := make([]int, 0, s.Len())
+	for j, w := range s {
+		for ; w != 0; w &= w - 1 {
+			z = append(z, j*64+bits.TrailingZeros64(w))
+		}
+	}
+	return z
+}
@@ -54,3 +54,128 @@ func TestCapNames(t *testing.T) {
 		}
 	}
 }
+
+func TestCapTermcapNames(t *testing.T) {
+	if CapCountBool != len(boolCapTermcapNames) {
+		t.Fatalf("boolCapTermcapNames should have same length as CapCountBool")
+	}
+	if CapCountNum != len(numCapTermcapNames) {
+		t.Fatalf("numCapTermcapNames should have same length as CapCountNum")
+	}
+	if CapCountString != len(stringCapTermcapNames) {
+		t.Fatalf("stringCapTermcapNames should have same length as CapCountString")
+	}
+}
+
+func TestLookupCap(t *testing.T) {
+	tests := []struct {
+		name string
+		kind CapKind
+		i    int
+		ok   bool
+	}{
+		{"setaf", CapKindString, SetAForeground, true},
+		{"set_a_foreground", CapKindString, SetAForeground, true},
+		{"AF", CapKindString, SetAForeground, true},
+		{"am", CapKindBool, AutoRightMargin, true},
+		{"colors", CapKindNum, MaxColors, true},
+		{"Co", CapKindNum, MaxColors, true},
+		{"lines", CapKindNum, Lines, true},
+		{"li", CapKindNum, Lines, true},
+		{"Tc", CapKindExtBool, 1, true},
+		{"Smulx", CapKindExtString, 0, true},
+		// extended names take precedence over termcap names
+		{"XF", CapKindExtString, 1, true},
+		{"xoffc", CapKindString, XoffCharacter, true},
+		{"missing", 0, 0, false},
+	}
+	ti := &Terminfo{
+		ExtBoolNames:   map[int][]byte{0: []byte("AX"), 1: []byte("Tc")},
+		ExtStringNames: map[int][]byte{0: []byte("Smulx"), 1: []byte("XF")},
+	}
+	for _, test := range tests {
+		kind, i, ok := ti.LookupCap(test.name)
+		if kind != test.kind || i != test.i || ok != test.ok {
+			t.Errorf("%s expected %s %d %t, got: %s %d %t", test.name, test.kind, test.i, test.ok, kind, i, ok)
+		}
+	}
+	if kind, i, ok := LookupCap("XF"); !ok || kind != CapKindString || i != XoffCharacter {
+		t.Errorf("XF expected termcap name of xoffc, got: %s %d %t", kind, i, ok)
+	}
+	for i := 0; i < CapCountString; i++ {
+		for _, n := range []string{StringCapName(i), StringCapNameShort(i)} {
+			if kind, j, ok := LookupCap(n); !ok || kind != CapKindString || j != i {
+				t.Errorf("string cap %d (%s) should lookup to itself, got: %s %d %t", i, n, kind, j, ok)
+			}
+		}
+	}
+}
+
+func TestCapInfos(t *testing.T) {
+	infos := CapInfos()
+	if len(infos) != CapCountBool+CapCountNum+CapCountString {
+		t.Fatalf("expected %d cap infos, got: %d", CapCountBool+CapCountNum+CapCountString, len(infos))
+	}
+	for _, c := range infos {
+		var name, short, termcap string
+		switch c.Kind {
+		case CapKindBool:
+			name, short, termcap = BoolCapName(c.Index), BoolCapNameShort(c.Index), BoolCapNameTermcap(c.Index)
+		case CapKindNum:
+			name, short, termcap = NumCapName(c.Index), NumCapNameShort(c.Index), NumCapNameTermcap(c.Index)
+		case CapKindString:
+			name, short, termcap = StringCapName(c.Index), StringCapNameShort(c.Index), StringCapNameTermcap(c.Index)
+		}
+		if c.Name != name || c.Short != short || c.Termcap != termcap {
+			t.Errorf("%s cap %d should be %s/%s/%s, got: %s/%s/%s", c.Kind, c.Index, name, short, termcap, c.Name, c.Short, c.Termcap)
+		}
+		if c.Description == "" {
+			t.Errorf("%s cap %d (%s) should have a description", c.Kind, c.Index, c.Name)
+		}
+		if (c.Category == CapCategoryKey) != (c.KeyName != "") {
+			t.Errorf("%s cap %d (%s) key name should only be set for key caps", c.Kind, c.Index, c.Name)
+		}
+	}
+}
+
+func TestCapAliases(t *testing.T) {
+	seen := make(map[CapAlias]bool)
+	for _, a := range CapAliases() {
+		if seen[a] {
+			t.Errorf("alias %s (%s) should not be repeated", a.Name, a.Source)
+		}
+		seen[a] = true
+		if _, ok := LookupCapInfo(a.Cap); a.Cap != "" && !ok {
+			t.Errorf("alias %s should be for a known cap, got: %s", a.Name, a.Cap)
+		}
+	}
+}
+
+func TestLookupCapInfo(t *testing.T) {
+	tests := []struct {
+		name     string
+		exp      string
+		category CapCategory
+		keyName  string
+	}{
+		{"kcud1", "key_down", CapCategoryKey, "KEY_DOWN"},
+		{"OTbs", "backspaces_with_bs", CapCategoryObsolete, ""},
+		{"box1", "box_chars_1", CapCategoryExtension, ""},
+		{"Co", "max_colors", CapCategoryStandard, ""},
+		{"kbtab", "key_btab", CapCategoryKey, "KEY_BTAB"},
+		{"sb", "scroll_reverse", CapCategoryStandard, ""},
+	}
+	for _, test := range tests {
+		c, ok := LookupCapInfo(test.name)
+		if !ok {
+			t.Errorf("%s expected to be found", test.name)
+			continue
+		}
+		if c.Name != test.exp || c.Category != test.category || c.KeyName != test.keyName {
+			t.Errorf("%s expected %s %s %q, got: %s %s %q", test.name, test.exp, test.category, test.keyName, c.Name, c.Category, c.KeyName)
+		}
+	}
+	if len(SearchCapInfos("FOREGROUND")) == 0 {
+		t.Errorf("expected search to find foreground caps")
+	}
+}
@@ -2021,3 +2021,2005 @@ var stringCapNames = [...]string{
 	"memory_unlock", "memu",
 	"box_chars_1", "box1",
 }
+
+// boolCapTermcapNames are the bool termcap names.
+var boolCapTermcapNames = [...]string{
+	"bw", // auto_left_margin
+	"am", // auto_right_margin
+	"xb", // no_esc_ctlc
+	"xs", // ceol_standout_glitch
+	"xn", // eat_newline_glitch
+	"eo", // erase_overstrike
+	"gn", // generic_type
+	"hc", // hard_copy
+	"km", // has_meta_key
+	"hs", // has_status_line
+	"in", // insert_null_glitch
+	"da", // memory_above
+	"db", // memory_below
+	"mi", // move_insert_mode
+	"ms", // move_standout_mode
+	"os", // over_strike
+	"es", // status_line_esc_ok
+	"xt", // dest_tabs_magic_smso
+	"hz", // tilde_glitch
+	"ul", // transparent_underline
+	"xo", // xon_xoff
+	"nx", // needs_xon_xoff
+	"5i", // prtr_silent
+	"HC", // hard_cursor
+	"NR", // non_rev_rmcup
+	"NP", // no_pad_char
+	"ND", // non_dest_scroll_region
+	"cc", // can_change
+	"ut", // back_color_erase
+	"hl", // hue_lightness_saturation
+	"YA", // col_addr_glitch
+	"YB", // cr_cancels_micro_mode
+	"YC", // has_print_wheel
+	"YD", // row_addr_glitch
+	"YE", // semi_auto_right_margin
+	"YF", // cpi_changes_res
+	"YG", // lpi_changes_res
+	"bs", // backspaces_with_bs
+	"ns", // crt_no_scrolling
+	"nc", // no_correctly_working_cr
+	"MT", // gnu_has_meta_key
+	"NL", // linefeed_is_newline
+	"pt", // has_hardware_tabs
+	"xr", // return_does_clr_eol
+}
+
+// numCapTermcapNames are the num termcap names.
+var numCapTermcapNames = [...]string{
+	"co", // columns
+	"it", // init_tabs
+	"li", // lines
+	"lm", // lines_of_memory
+	"sg", // magic_cookie_glitch
+	"pb", // padding_baud_rate
+	"vt", // virtual_terminal
+	"ws", // width_status_line
+	"Nl", // num_labels
+	"lh", // label_height
+	"lw", // label_width
+	"ma", // max_attributes
+	"MW", // maximum_windows
+	"Co", // max_colors
+	"pa", // max_pairs
+	"NC", // no_color_video
+	"Ya", // buffer_capacity
+	"Yb", // dot_vert_spacing
+	"Yc", // dot_horz_spacing
+	"Yd", // max_micro_address
+	"Ye", // max_micro_jump
+	"Yf", // micro_col_size
+	"Yg", // micro_line_size
+	"Yh", // number_of_pins
+	"Yi", // output_res_char
+	"Yj", // output_res_line
+	"Yk", // output_res_horz_inch
+	"Yl", // output_res_vert_inch
+	"Ym", // print_rate
+	"Yn", // wide_char_size
+	"BT", // buttons
+	"Yo", // bit_image_entwining
+	"Yp", // bit_image_type
+	"ug", // magic_cookie_glitch_ul
+	"dC", // carriage_return_delay
+	"dN", // new_line_delay
+	"dB", // backspace_delay
+	"dT", // horizontal_tab_delay
+	"kn", // number_of_function_keys
+}
+
+// stringCapTermcapNames are the string termcap names.
+var stringCapTermcapNames = [...]string{
+	"bt", // back_tab
+	"bl", // bell
+	"cr", // carriage_return
+	"cs", // change_scroll_region
+	"ct", // clear_all_tabs
+	"cl", // clear_screen
+	"ce", // clr_eol
+	"cd", // clr_eos
+	"ch", // column_address
+	"CC", // command_character
+	"cm", // cursor_address
+	"do", // cursor_down
+	"ho", // cursor_home
+	"vi", // cursor_invisible
+	"le", // cursor_left
+	"CM", // cursor_mem_address
+	"ve", // cursor_normal
+	"nd", // cursor_right
+	"ll", // cursor_to_ll
+	"up", // cursor_up
+	"vs", // cursor_visible
+	"dc", // delete_character
+	"dl", // delete_line
+	"ds", // dis_status_line
+	"hd", // down_half_line
+	"as", // enter_alt_charset_mode
+	"mb", // enter_blink_mode
+	"md", // enter_bold_mode
+	"ti", // enter_ca_mode
+	"dm", // enter_delete_mode
+	"mh", // enter_dim_mode
+	"im", // enter_insert_mode
+	"mk", // enter_secure_mode
+	"mp", // enter_protected_mode
+	"mr", // enter_reverse_mode
+	"so", // enter_standout_mode
+	"us", // enter_underline_mode
+	"ec", // erase_chars
+	"ae", // exit_alt_charset_mode
+	"me", // exit_attribute_mode
+	"te", // exit_ca_mode
+	"ed", // exit_delete_mode
+	"ei", // exit_insert_mode
+	"se", // exit_standout_mode
+	"ue", // exit_underline_mode
+	"vb", // flash_screen
+	"ff", // form_feed
+	"fs", // from_status_line
+	"i1", // init_1string
+	"is", // init_2string
+	"i3", // init_3string
+	"if", // init_file
+	"ic", // insert_character
+	"al", // insert_line
+	"ip", // insert_padding
+	"kb", // key_backspace
+	"ka", // key_catab
+	"kC", // key_clear
+	"kt", // key_ctab
+	"kD", // key_dc
+	"kL", // key_dl
+	"kd", // key_down
+	"kM", // key_eic
+	"kE", // key_eol
+	"kS", // key_eos
+	"k0", // key_f0
+	"k1", // key_f1
+	"k;", // key_f10
+	"k2", // key_f2
+	"k3", // key_f3
+	"k4", // key_f4
+	"k5", // key_f5
+	"k6", // key_f6
+	"k7", // key_f7
+	"k8", // key_f8
+	"k9", // key_f9
+	"kh", // key_home
+	"kI", // key_ic
+	"kA", // key_il
+	"kl", // key_left
+	"kH", // key_ll
+	"kN", // key_npage
+	"kP", // key_ppage
+	"kr", // key_right
+	"kF", // key_sf
+	"kR", // key_sr
+	"kT", // key_stab
+	"ku", // key_up
+	"ke", // keypad_local
+	"ks", // keypad_xmit
+	"l0", // lab_f0
+	"l1", // lab_f1
+	"la", // lab_f10
+	"l2", // lab_f2
+	"l3", // lab_f3
+	"l4", // lab_f4
+	"l5", // lab_f5
+	"l6", // lab_f6
+	"l7", // lab_f7
+	"l8", // lab_f8
+	"l9", // lab_f9
+	"mo", // meta_off
+	"mm", // meta_on
+	"nw", // newline
+	"pc", // pad_char
+	"DC", // parm_dch
+	"DL", // parm_delete_line
+	"DO", // parm_down_cursor
+	"IC", // parm_ich
+	"SF", // parm_index
+	"AL", // parm_insert_line
+	"LE", // parm_left_cursor
+	"RI", // parm_right_cursor
+	"SR", // parm_rindex
+	"UP", // parm_up_cursor
+	"pk", // pkey_key
+	"pl", // pkey_local
+	"px", // pkey_xmit
+	"ps", // print_screen
+	"pf", // prtr_off
+	"po", // prtr_on
+	"rp", // repeat_char
+	"r1", // reset_1string
+	"r2", // reset_2string
+	"r3", // reset_3string
+	"rf", // reset_file
+	"rc", // restore_cursor
+	"cv", // row_address
+	"sc", // save_cursor
+	"sf", // scroll_forward
+	"sr", // scroll_reverse
+	"sa", // set_attributes
+	"st", // set_tab
+	"wi", // set_window
+	"ta", // tab
+	"ts", // to_status_line
+	"uc", // underline_char
+	"hu", // up_half_line
+	"iP", // init_prog
+	"K1", // key_a1
+	"K3", // key_a3
+	"K2", // key_b2
+	"K4", // key_c1
+	"K5", // key_c3
+	"pO", // prtr_non
+	"rP", // char_padding
+	"ac", // acs_chars
+	"pn", // plab_norm
+	"kB", // key_btab
+	"SX", // enter_xon_mode
+	"RX", // exit_xon_mode
+	"SA", // enter_am_mode
+	"RA", // exit_am_mode
+	"XN", // xon_character
+	"XF", // xoff_character
+	"eA", // ena_acs
+	"LO", // label_on
+	"LF", // label_off
+	"@1", // key_beg
+	"@2", // key_cancel
+	"@3", // key_close
+	"@4", // key_command
+	"@5", // key_copy
+	"@6", // key_create
+	"@7", // key_end
+	"@8", // key_enter
+	"@9", // key_exit
+	"@0", // key_find
+	"%1", // key_help
+	"%2", // key_mark
+	"%3", // key_message
+	"%4", // key_move
+	"%5", // key_next
+	"%6", // key_open
+	"%7", // key_options
+	"%8", // key_previous
+	"%9", // key_print
+	"%0", // key_redo
+	"&1", // key_reference
+	"&2", // key_refresh
+	"&3", // key_replace
+	"&4", // key_restart
+	"&5", // key_resume
+	"&6", // key_save
+	"&7", // key_suspend
+	"&8", // key_undo
+	"&9", // key_sbeg
+	"&0", // key_scancel
+	"*1", // key_scommand
+	"*2", // key_scopy
+	"*3", // key_screate
+	"*4", // key_sdc
+	"*5", // key_sdl
+	"*6", // key_select
+	"*7", // key_send
+	"*8", // key_seol
+	"*9", // key_sexit
+	"*0", // key_sfind
+	"#1", // key_shelp
+	"#2", // key_shome
+	"#3", // key_sic
+	"#4", // key_sleft
+	"%a", // key_smessage
+	"%b", // key_smove
+	"%c", // key_snext
+	"%d", // key_soptions
+	"%e", // key_sprevious
+	"%f", // key_sprint
+	"%g", // key_sredo
+	"%h", // key_sreplace
+	"%i", // key_sright
+	"%j", // key_srsume
+	"!1", // key_ssave
+	"!2", // key_ssuspend
+	"!3", // key_sundo
+	"RF", // req_for_input
+	"F1", // key_f11
+	"F2", // key_f12
+	"F3", // key_f13
+	"F4", // key_f14
+	"F5", // key_f15
+	"F6", // key_f16
+	"F7", // key_f17
+	"F8", // key_f18
+	"F9", // key_f19
+	"FA", // key_f20
+	"FB", // key_f21
+	"FC", // key_f22
+	"FD", // key_f23
+	"FE", // key_f24
+	"FF", // key_f25
+	"FG", // key_f26
+	"FH", // key_f27
+	"FI", // key_f28
+	"FJ", // key_f29
+	"FK", // key_f30
+	"FL", // key_f31
+	"FM", // key_f32
+	"FN", // key_f33
+	"FO", // key_f34
+	"FP", // key_f35
+	"FQ", // key_f36
+	"FR", // key_f37
+	"FS", // key_f38
+	"FT", // key_f39
+	"FU", // key_f40
+	"FV", // key_f41
+	"FW", // key_f42
+	"FX", // key_f43
+	"FY", // key_f44
+	"FZ", // key_f45
+	"Fa", // key_f46
+	"Fb", // key_f47
+	"Fc", // key_f48
+	"Fd", // key_f49
+	"Fe", // key_f50
+	"Ff", // key_f51
+	"Fg", // key_f52
+	"Fh", // key_f53
+	"Fi", // key_f54
+	"Fj", // key_f55
+	"Fk", // key_f56
+	"Fl", // key_f57
+	"Fm", // key_f58
+	"Fn", // key_f59
+	"Fo", // key_f60
+	"Fp", // key_f61
+	"Fq", // key_f62
+	"Fr", // key_f63
+	"cb", // clr_bol
+	"MC", // clear_margins
+	"ML", // set_left_margin
+	"MR", // set_right_margin
+	"Lf", // label_format
+	"SC", // set_clock
+	"DK", // display_clock
+	"RC", // remove_clock
+	"CW", // create_window
+	"WG", // goto_window
+	"HU", // hangup
+	"DI", // dial_phone
+	"QD", // quick_dial
+	"TO", // tone
+	"PU", // pulse
+	"fh", // flash_hook
+	"PA", // fixed_pause
+	"WA", // wait_tone
+	"u0", // user0
+	"u1", // user1
+	"u2", // user2
+	"u3", // user3
+	"u4", // user4
+	"u5", // user5
+	"u6", // user6
+	"u7", // user7
+	"u8", // user8
+	"u9", // user9
+	"op", // orig_pair
+	"oc", // orig_colors
+	"Ic", // initialize_color
+	"Ip", // initialize_pair
+	"sp", // set_color_pair
+	"Sf", // set_foreground
+	"Sb", // set_background
+	"ZA", // change_char_pitch
+	"ZB", // change_line_pitch
+	"ZC", // change_res_horz
+	"ZD", // change_res_vert
+	"ZE", // define_char
+	"ZF", // enter_doublewide_mode
+	"ZG", // enter_draft_quality
+	"ZH", // enter_italics_mode
+	"ZI", // enter_leftward_mode
+	"ZJ", // enter_micro_mode
+	"ZK", // enter_near_letter_quality
+	"ZL", // enter_normal_quality
+	"ZM", // enter_shadow_mode
+	"ZN", // enter_subscript_mode
+	"ZO", // enter_superscript_mode
+	"ZP", // enter_upward_mode
+	"ZQ", // exit_doublewide_mode
+	"ZR", // exit_italics_mode
+	"ZS", // exit_leftward_mode
+	"ZT", // exit_micro_mode
+	"ZU", // exit_shadow_mode
+	"ZV", // exit_subscript_mode
+	"ZW", // exit_superscript_mode
+	"ZX", // exit_upward_mode
+	"ZY", // micro_column_address
+	"ZZ", // micro_down
+	"Za", // micro_left
+	"Zb", // micro_right
+	"Zc", // micro_row_address
+	"Zd", // micro_up
+	"Ze", // order_of_pins
+	"Zf", // parm_down_micro
+	"Zg", // parm_left_micro
+	"Zh", // parm_right_micro
+	"Zi", // parm_up_micro
+	"Zj", // select_char_set
+	"Zk", // set_bottom_margin
+	"Zl", // set_bottom_margin_parm
+	"Zm", // set_left_margin_parm
+	"Zn", // set_right_margin_parm
+	"Zo", // set_top_margin
+	"Zp", // set_top_margin_parm
+	"Zq", // start_bit_image
+	"Zr", // start_char_set_def
+	"Zs", // stop_bit_image
+	"Zt", // stop_char_set_def
+	"Zu", // subscript_characters
+	"Zv", // superscript_characters
+	"Zw", // these_cause_cr
+	"Zx", // zero_motion
+	"Zy", // char_set_names
+	"Km", // key_mouse
+	"Mi", // mouse_info
+	"RQ", // req_mouse_pos
+	"Gm", // get_mouse
+	"AF", // set_a_foreground
+	"AB", // set_a_background
+	"xl", // pkey_plab
+	"dv", // device_type
+	"ci", // code_set_init
+	"s0", // set0_des_seq
+	"s1", // set1_des_seq
+	"s2", // set2_des_seq
+	"s3", // set3_des_seq
+	"ML", // set_lr_margin
+	"MT", // set_tb_margin
+	"Xy", // bit_image_repeat
+	"Zz", // bit_image_newline
+	"Yv", // bit_image_carriage_return
+	"Yw", // color_names
+	"Yx", // define_bit_image_region
+	"Yy", // end_bit_image_region
+	"Yz", // set_color_band
+	"YZ", // set_page_length
+	"S1", // display_pc_char
+	"S2", // enter_pc_charset_mode
+	"S3", // exit_pc_charset_mode
+	"S4", // enter_scancode_mode
+	"S5", // exit_scancode_mode
+	"S6", // pc_term_options
+	"S7", // scancode_escape
+	"S8", // alt_scancode_esc
+	"Xh", // enter_horizontal_hl_mode
+	"Xl", // enter_left_hl_mode
+	"Xo", // enter_low_hl_mode
+	"Xr", // enter_right_hl_mode
+	"Xt", // enter_top_hl_mode
+	"Xv", // enter_vertical_hl_mode
+	"sA", // set_a_attributes
+	"YI", // set_pglen_inch
+	"i2", // termcap_init2
+	"rs", // termcap_reset
+	"nl", // linefeed_if_not_lf
+	"bc", // backspace_if_not_bs
+	"ko", // other_non_function_keys
+	"ma", // arrow_key_map
+	"G2", // acs_ulcorner
+	"G3", // acs_llcorner
+	"G1", // acs_urcorner
+	"G4", // acs_lrcorner
+	"GR", // acs_ltee
+	"GL", // acs_rtee
+	"GU", // acs_btee
+	"GD", // acs_ttee
+	"GH", // acs_hline
+	"GV", // acs_vline
+	"GC", // acs_plus
+	"ml", // memory_lock
+	"mu", // memory_unlock
+	"bx", // box_chars_1
+}
+
+// capNames are the long and short term cap names mapped to their kind and index.
+var capNames = map[string]capIndex{
+	"auto_left_margin":          {CapKindBool, AutoLeftMargin},
+	"bw":                        {CapKindBool, AutoLeftMargin},
+	"auto_right_margin":         {CapKindBool, AutoRightMargin},
+	"am":                        {CapKindBool, AutoRightMargin},
+	"no_esc_ctlc":               {CapKindBool, NoEscCtlc},
+	"xsb":                       {CapKindBool, NoEscCtlc},
+	"ceol_standout_glitch":      {CapKindBool, CeolStandoutGlitch},
+	"xhp":                       {CapKindBool, CeolStandoutGlitch},
+	"eat_newline_glitch":        {CapKindBool, EatNewlineGlitch},
+	"xenl":                      {CapKindBool, EatNewlineGlitch},
+	"erase_overstrike":          {CapKindBool, EraseOverstrike},
+	"eo":                        {CapKindBool, EraseOverstrike},
+	"generic_type":              {CapKindBool, GenericType},
+	"gn":                        {CapKindBool, GenericType},
+	"hard_copy":                 {CapKindBool, HardCopy},
+	"hc":                        {CapKindBool, HardCopy},
+	"has_meta_key":              {CapKindBool, HasMetaKey},
+	"km":                        {CapKindBool, HasMetaKey},
+	"has_status_line":           {CapKindBool, HasStatusLine},
+	"hs":                        {CapKindBool, HasStatusLine},
+	"insert_null_glitch":        {CapKindBool, InsertNullGlitch},
+	"in":                        {CapKindBool, InsertNullGlitch},
+	"memory_above":              {CapKindBool, MemoryAbove},
+	"da":                        {CapKindBool, MemoryAbove},
+	"memory_below":              {CapKindBool, MemoryBelow},
+	"db":                        {CapKindBool, MemoryBelow},
+	"move_insert_mode":          {CapKindBool, MoveInsertMode},
+	"mir":                       {CapKindBool, MoveInsertMode},
+	"move_standout_mode":        {CapKindBool, MoveStandoutMode},
+	"msgr":                      {CapKindBool, MoveStandoutMode},
+	"over_strike":               {CapKindBool, OverStrike},
+	"os":                        {CapKindBool, OverStrike},
+	"status_line_esc_ok":        {CapKindBool, StatusLineEscOk},
+	"eslok":                     {CapKindBool, StatusLineEscOk},
+	"dest_tabs_magic_smso":      {CapKindBool, DestTabsMagicSmso},
+	"xt":                        {CapKindBool, DestTabsMagicSmso},
+	"tilde_glitch":              {CapKindBool, TildeGlitch},
+	"hz":                        {CapKindBool, TildeGlitch},
+	"transparent_underline":     {CapKindBool, TransparentUnderline},
+	"ul":                        {CapKindBool, TransparentUnderline},
+	"xon_xoff":                  {CapKindBool, XonXoff},
+	"xon":                       {CapKindBool, XonXoff},
+	"needs_xon_xoff":            {CapKindBool, NeedsXonXoff},
+	"nxon":                      {CapKindBool, NeedsXonXoff},
+	"prtr_silent":               {CapKindBool, PrtrSilent},
+	"mc5i":                      {CapKindBool, PrtrSilent},
+	"hard_cursor":               {CapKindBool, HardCursor},
+	"chts":                      {CapKindBool, HardCursor},
+	"non_rev_rmcup":             {CapKindBool, NonRevRmcup},
+	"nrrmc":                     {CapKindBool, NonRevRmcup},
+	"no_pad_char":               {CapKindBool, NoPadChar},
+	"npc":                       {CapKindBool, NoPadChar},
+	"non_dest_scroll_region":    {CapKindBool, NonDestScrollRegion},
+	"ndscr":                     {CapKindBool, NonDestScrollRegion},
+	"can_change":                {CapKindBool, CanChange},
+	"ccc":                       {CapKindBool, CanChange},
+	"back_color_erase":          {CapKindBool, BackColorErase},
+	"bce":                       {CapKindBool, BackColorErase},
+	"hue_lightness_saturation":  {CapKindBool, HueLightnessSaturation},
+	"hls":                       {CapKindBool, HueLightnessSaturation},
+	"col_addr_glitch":           {CapKindBool, ColAddrGlitch},
+	"xhpa":                      {CapKindBool, ColAddrGlitch},
+	"cr_cancels_micro_mode":     {CapKindBool, CrCancelsMicroMode},
+	"crxm":                      {CapKindBool, CrCancelsMicroMode},
+	"has_print_wheel":           {CapKindBool, HasPrintWheel},
+	"daisy":                     {CapKindBool, HasPrintWheel},
+	"row_addr_glitch":           {CapKindBool, RowAddrGlitch},
+	"xvpa":                      {CapKindBool, RowAddrGlitch},
+	"semi_auto_right_margin":    {CapKindBool, SemiAutoRightMargin},
+	"sam":                       {CapKindBool, SemiAutoRightMargin},
+	"cpi_changes_res":           {CapKindBool, CpiChangesRes},
+	"cpix":                      {CapKindBool, CpiChangesRes},
+	"lpi_changes_res":           {CapKindBool, LpiChangesRes},
+	"lpix":                      {CapKindBool, LpiChangesRes},
+	"backspaces_with_bs":        {CapKindBool, BackspacesWithBs},
+	"OTbs":                      {CapKindBool, BackspacesWithBs},
+	"crt_no_scrolling":          {CapKindBool, CrtNoScrolling},
+	"OTns":                      {CapKindBool, CrtNoScrolling},
+	"no_correctly_working_cr":   {CapKindBool, NoCorrectlyWorkingCr},
+	"OTnc":                      {CapKindBool, NoCorrectlyWorkingCr},
+	"gnu_has_meta_key":          {CapKindBool, GnuHasMetaKey},
+	"OTMT":                      {CapKindBool, GnuHasMetaKey},
+	"linefeed_is_newline":       {CapKindBool, LinefeedIsNewline},
+	"OTNL":                      {CapKindBool, LinefeedIsNewline},
+	"has_hardware_tabs":         {CapKindBool, HasHardwareTabs},
+	"OTpt":                      {CapKindBool, HasHardwareTabs},
+	"return_does_clr_eol":       {CapKindBool, ReturnDoesClrEol},
+	"OTxr":                      {CapKindBool, ReturnDoesClrEol},
+	"columns":                   {CapKindNum, Columns},
+	"cols":                      {CapKindNum, Columns},
+	"init_tabs":                 {CapKindNum, InitTabs},
+	"it":                        {CapKindNum, InitTabs},
+	"lines":                     {CapKindNum, Lines},
+	"lines_of_memory":           {CapKindNum, LinesOfMemory},
+	"lm":                        {CapKindNum, LinesOfMemory},
+	"magic_cookie_glitch":       {CapKindNum, MagicCookieGlitch},
+	"xmc":                       {CapKindNum, MagicCookieGlitch},
+	"padding_baud_rate":         {CapKindNum, PaddingBaudRate},
+	"pb":                        {CapKindNum, PaddingBaudRate},
+	"virtual_terminal":          {CapKindNum, VirtualTerminal},
+	"vt":                        {CapKindNum, VirtualTerminal},
+	"width_status_line":         {CapKindNum, WidthStatusLine},
+	"wsl":                       {CapKindNum, WidthStatusLine},
+	"num_labels":                {CapKindNum, NumLabels},
+	"nlab":                      {CapKindNum, NumLabels},
+	"label_height":              {CapKindNum, LabelHeight},
+	"lh":                        {CapKindNum, LabelHeight},
+	"label_width":               {CapKindNum, LabelWidth},
+	"lw":                        {CapKindNum, LabelWidth},
+	"max_attributes":            {CapKindNum, MaxAttributes},
+	"ma":                        {CapKindNum, MaxAttributes},
+	"maximum_windows":           {CapKindNum, MaximumWindows},
+	"wnum":                      {CapKindNum, MaximumWindows},
+	"max_colors":                {CapKindNum, MaxColors},
+	"colors":                    {CapKindNum, MaxColors},
+	"max_pairs":                 {CapKindNum, MaxPairs},
+	"pairs":                     {CapKindNum, MaxPairs},
+	"no_color_video":            {CapKindNum, NoColorVideo},
+	"ncv":                       {CapKindNum, NoColorVideo},
+	"buffer_capacity":           {CapKindNum, BufferCapacity},
+	"bufsz":                     {CapKindNum, BufferCapacity},
+	"dot_vert_spacing":          {CapKindNum, DotVertSpacing},
+	"spinv":                     {CapKindNum, DotVertSpacing},
+	"dot_horz_spacing":          {CapKindNum, DotHorzSpacing},
+	"spinh":                     {CapKindNum, DotHorzSpacing},
+	"max_micro_address":         {CapKindNum, MaxMicroAddress},
+	"maddr":                     {CapKindNum, MaxMicroAddress},
+	"max_micro_jump":            {CapKindNum, MaxMicroJump},
+	"mjump":                     {CapKindNum, MaxMicroJump},
+	"micro_col_size":            {CapKindNum, MicroColSize},
+	"mcs":                       {CapKindNum, MicroColSize},
+	"micro_line_size":           {CapKindNum, MicroLineSize},
+	"mls":                       {CapKindNum, MicroLineSize},
+	"number_of_pins":            {CapKindNum, NumberOfPins},
+	"npins":                     {CapKindNum, NumberOfPins},
+	"output_res_char":           {CapKindNum, OutputResChar},
+	"orc":                       {CapKindNum, OutputResChar},
+	"output_res_line":           {CapKindNum, OutputResLine},
+	"orl":                       {CapKindNum, OutputResLine},
+	"output_res_horz_inch":      {CapKindNum, OutputResHorzInch},
+	"orhi":                      {CapKindNum, OutputResHorzInch},
+	"output_res_vert_inch":      {CapKindNum, OutputResVertInch},
+	"orvi":                      {CapKindNum, OutputResVertInch},
+	"print_rate":                {CapKindNum, PrintRate},
+	"cps":                       {CapKindNum, PrintRate},
+	"wide_char_size":            {CapKindNum, WideCharSize},
+	"widcs":                     {CapKindNum, WideCharSize},
+	"buttons":                   {CapKindNum, Buttons},
+	"btns":                      {CapKindNum, Buttons},
+	"bit_image_entwining":       {CapKindNum, BitImageEntwining},
+	"bitwin":                    {CapKindNum, BitImageEntwining},
+	"bit_image_type":            {CapKindNum, BitImageType},
+	"bitype":                    {CapKindNum, BitImageType},
+	"magic_cookie_glitch_ul":    {CapKindNum, MagicCookieGlitchUl},
+	"OTug":                      {CapKindNum, MagicCookieGlitchUl},
+	"carriage_return_delay":     {CapKindNum, CarriageReturnDelay},
+	"OTdC":                      {CapKindNum, CarriageReturnDelay},
+	"new_line_delay":            {CapKindNum, NewLineDelay},
+	"OTdN":                      {CapKindNum, NewLineDelay},
+	"backspace_delay":           {CapKindNum, BackspaceDelay},
+	"OTdB":                      {CapKindNum, BackspaceDelay},
+	"horizontal_tab_delay":      {CapKindNum, HorizontalTabDelay},
+	"OTdT":                      {CapKindNum, HorizontalTabDelay},
+	"number_of_function_keys":   {CapKindNum, NumberOfFunctionKeys},
+	"OTkn":                      {CapKindNum, NumberOfFunctionKeys},
+	"back_tab":                  {CapKindString, BackTab},
+	"cbt":                       {CapKindString, BackTab},
+	"bell":                      {CapKindString, Bell},
+	"bel":                       {CapKindString, Bell},
+	"carriage_return":           {CapKindString, CarriageReturn},
+	"cr":                        {CapKindString, CarriageReturn},
+	"change_scroll_region":      {CapKindString, ChangeScrollRegion},
+	"csr":                       {CapKindString, ChangeScrollRegion},
+	"clear_all_tabs":            {CapKindString, ClearAllTabs},
+	"tbc":                       {CapKindString, ClearAllTabs},
+	"clear_screen":              {CapKindString, ClearScreen},
+	"clear":                     {CapKindString, ClearScreen},
+	"clr_eol":                   {CapKindString, ClrEol},
+	"el":                        {CapKindString, ClrEol},
+	"clr_eos":                   {CapKindString, ClrEos},
+	"ed":                        {CapKindString, ClrEos},
+	"column_address":            {CapKindString, ColumnAddress},
+	"hpa":                       {CapKindString, ColumnAddress},
+	"command_character":         {CapKindString, CommandCharacter},
+	"cmdch":                     {CapKindString, CommandCharacter},
+	"cursor_address":            {CapKindString, CursorAddress},
+	"cup":                       {CapKindString, CursorAddress},
+	"cursor_down":               {CapKindString, CursorDown},
+	"cud1":                      {CapKindString, CursorDown},
+	"cursor_home":               {CapKindString, CursorHome},
+	"home":                      {CapKindString, CursorHome},
+	"cursor_invisible":          {CapKindString, CursorInvisible},
+	"civis":                     {CapKindString, CursorInvisible},
+	"cursor_left":               {CapKindString, CursorLeft},
+	"cub1":                      {CapKindString, CursorLeft},
+	"cursor_mem_address":        {CapKindString, CursorMemAddress},
+	"mrcup":                     {CapKindString, CursorMemAddress},
+	"cursor_normal":             {CapKindString, CursorNormal},
+	"cnorm":                     {CapKindString, CursorNormal},
+	"cursor_right":              {CapKindString, CursorRight},
+	"cuf1":                      {CapKindString, CursorRight},
+	"cursor_to_ll":              {CapKindString, CursorToLl},
+	"ll":                        {CapKindString, CursorToLl},
+	"cursor_up":                 {CapKindString, CursorUp},
+	"cuu1":                      {CapKindString, CursorUp},
+	"cursor_visible":            {CapKindString, CursorVisible},
+	"cvvis":                     {CapKindString, CursorVisible},
+	"delete_character":          {CapKindString, DeleteCharacter},
+	"dch1":                      {CapKindString, DeleteCharacter},
+	"delete_line":               {CapKindString, DeleteLine},
+	"dl1":                       {CapKindString, DeleteLine},
+	"dis_status_line":           {CapKindString, DisStatusLine},
+	"dsl":                       {CapKindString, DisStatusLine},
+	"down_half_line":            {CapKindString, DownHalfLine},
+	"hd":                        {CapKindString, DownHalfLine},
+	"enter_alt_charset_mode":    {CapKindString, EnterAltCharsetMode},
+	"smacs":                     {CapKindString, EnterAltCharsetMode},
+	"enter_blink_mode":          {CapKindString, EnterBlinkMode},
+	"blink":                     {CapKindString, EnterBlinkMode},
+	"enter_bold_mode":           {CapKindString, EnterBoldMode},
+	"bold":                      {CapKindString, EnterBoldMode},
+	"enter_ca_mode":             {CapKindString, EnterCaMode},
+	"smcup":                     {CapKindString, EnterCaMode},
+	"enter_delete_mode":         {CapKindString, EnterDeleteMode},
+	"smdc":                      {CapKindString, EnterDeleteMode},
+	"enter_dim_mode":            {CapKindString, EnterDimMode},
+	"dim":                       {CapKindString, EnterDimMode},
+	"enter_insert_mode":         {CapKindString, EnterInsertMode},
+	"smir":                      {CapKindString, EnterInsertMode},
+	"enter_secure_mode":         {CapKindString, EnterSecureMode},
+	"invis":                     {CapKindString, EnterSecureMode},
+	"enter_protected_mode":      {CapKindString, EnterProtectedMode},
+	"prot":                      {CapKindString, EnterProtectedMode},
+	"enter_reverse_mode":        {CapKindString, EnterReverseMode},
+	"rev":                       {CapKindString, EnterReverseMode},
+	"enter_standout_mode":       {CapKindString, EnterStandoutMode},
+	"smso":                      {CapKindString, EnterStandoutMode},
+	"enter_underline_mode":      {CapKindString, EnterUnderlineMode},
+	"smul":                      {CapKindString, EnterUnderlineMode},
+	"erase_chars":               {CapKindString, EraseChars},
+	"ech":                       {CapKindString, EraseChars},
+	"exit_alt_charset_mode":     {CapKindString, ExitAltCharsetMode},
+	"rmacs":                     {CapKindString, ExitAltCharsetMode},
+	"exit_attribute_mode":       {CapKindString, ExitAttributeMode},
+	"sgr0":                      {CapKindString, ExitAttributeMode},
+	"exit_ca_mode":              {CapKindString, ExitCaMode},
+	"rmcup":                     {CapKindString, ExitCaMode},
+	"exit_delete_mode":          {CapKindString, ExitDeleteMode},
+	"rmdc":                      {CapKindString, ExitDeleteMode},
+	"exit_insert_mode":          {CapKindString, ExitInsertMode},
+	"rmir":                      {CapKindString, ExitInsertMode},
+	"exit_standout_mode":        {CapKindString, ExitStandoutMode},
+	"rmso":                      {CapKindString, ExitStandoutMode},
+	"exit_underline_mode":       {CapKindString, ExitUnderlineMode},
+	"rmul":                      {CapKindString, ExitUnderlineMode},
+	"flash_screen":              {CapKindString, FlashScreen},
+	"flash":                     {CapKindString, FlashScreen},
+	"form_feed":                 {CapKindString, FormFeed},
+	"ff":                        {CapKindString, FormFeed},
+	"from_status_line":          {CapKindString, FromStatusLine},
+	"fsl":                       {CapKindString, FromStatusLine},
+	"init_1string":              {CapKindString, Init1string},
+	"is1":                       {CapKindString, Init1string},
+	"init_2string":              {CapKindString, Init2string},
+	"is2":                       {CapKindString, Init2string},
+	"init_3string":              {CapKindString, Init3string},
+	"is3":                       {CapKindString, Init3string},
+	"init_file":                 {CapKindString, InitFile},
+	"if":                        {CapKindString, InitFile},
+	"insert_character":          {CapKindString, InsertCharacter},
+	"ich1":                      {CapKindString, InsertCharacter},
+	"insert_line":               {CapKindString, InsertLine},
+	"il1":                       {CapKindString, InsertLine},
+	"insert_padding":            {CapKindString, InsertPadding},
+	"ip":                        {CapKindString, InsertPadding},
+	"key_backspace":             {CapKindString, KeyBackspace},
+	"kbs":                       {CapKindString, KeyBackspace},
+	"key_catab":                 {CapKindString, KeyCatab},
+	"ktbc":                      {CapKindString, KeyCatab},
+	"key_clear":                 {CapKindString, KeyClear},
+	"kclr":                      {CapKindString, KeyClear},
+	"key_ctab":                  {CapKindString, KeyCtab},
+	"kctab":                     {CapKindString, KeyCtab},
+	"key_dc":                    {CapKindString, KeyDc},
+	"kdch1":                     {CapKindString, KeyDc},
+	"key_dl":                    {CapKindString, KeyDl},
+	"kdl1":                      {CapKindString, KeyDl},
+	"key_down":                  {CapKindString, KeyDown},
+	"kcud1":                     {CapKindString, KeyDown},
+	"key_eic":                   {CapKindString, KeyEic},
+	"krmir":                     {CapKindString, KeyEic},
+	"key_eol":                   {CapKindString, KeyEol},
+	"kel":                       {CapKindString, KeyEol},
+	"key_eos":                   {CapKindString, KeyEos},
+	"ked":                       {CapKindString, KeyEos},
+	"key_f0":                    {CapKindString, KeyF0},
+	"kf0":                       {CapKindString, KeyF0},
+	"key_f1":                    {CapKindString, KeyF1},
+	"kf1":                       {CapKindString, KeyF1},
+	"key_f10":                   {CapKindString, KeyF10},
+	"kf10":                      {CapKindString, KeyF10},
+	"key_f2":                    {CapKindString, KeyF2},
+	"kf2":                       {CapKindString, KeyF2},
+	"key_f3":                    {CapKindString, KeyF3},
+	"kf3":                       {CapKindString, KeyF3},
+	"key_f4":                    {CapKindString, KeyF4},
+	"kf4":                       {CapKindString, KeyF4},
+	"key_f5":                    {CapKindString, KeyF5},
+	"kf5":                       {CapKindString, KeyF5},
+	"key_f6":                    {CapKindString, KeyF6},
+	"kf6":                       {CapKindString, KeyF6},
+	"key_f7":                    {CapKindString, KeyF7},
+	"kf7":                       {CapKindString, KeyF7},
+	"key_f8":                    {CapKindString, KeyF8},
+	"kf8":                       {CapKindString, KeyF8},
+	"key_f9":                    {CapKindString, KeyF9},
+	"kf9":                       {CapKindString, KeyF9},
+	"key_home":                  {CapKindString, KeyHome},
+	"khome":                     {CapKindString, KeyHome},
+	"key_ic":                    {CapKindString, KeyIc},
+	"kich1":                     {CapKindString, KeyIc},
+	"key_il":                    {CapKindString, KeyIl},
+	"kil1":                      {CapKindString, KeyIl},
+	"key_left":                  {CapKindString, KeyLeft},
+	"kcub1":                     {CapKindString, KeyLeft},
+	"key_ll":                    {CapKindString, KeyLl},
+	"kll":                       {CapKindString, KeyLl},
+	"key_npage":                 {CapKindString, KeyNpage},
+	"knp":                       {CapKindString, KeyNpage},
+	"key_ppage":                 {CapKindString, KeyPpage},
+	"kpp":                       {CapKindString, KeyPpage},
+	"key_right":                 {CapKindString, KeyRight},
+	"kcuf1":                     {CapKindString, KeyRight},
+	"key_sf":                    {CapKindString, KeySf},
+	"kind":                      {CapKindString, KeySf},
+	"key_sr":                    {CapKindString, KeySr},
+	"kri":                       {CapKindString, KeySr},
+	"key_stab":                  {CapKindString, KeyStab},
+	"khts":                      {CapKindString, KeyStab},
+	"key_up":                    {CapKindString, KeyUp},
+	"kcuu1":                     {CapKindString, KeyUp},
+	"keypad_local":              {CapKindString, KeypadLocal},
+	"rmkx":                      {CapKindString, KeypadLocal},
+	"keypad_xmit":               {CapKindString, KeypadXmit},
+	"smkx":                      {CapKindString, KeypadXmit},
+	"lab_f0":                    {CapKindString, LabF0},
+	"lf0":                       {CapKindString, LabF0},
+	"lab_f1":                    {CapKindString, LabF1},
+	"lf1":                       {CapKindString, LabF1},
+	"lab_f10":                   {CapKindString, LabF10},
+	"lf10":                      {CapKindString, LabF10},
+	"lab_f2":                    {CapKindString, LabF2},
+	"lf2":                       {CapKindString, LabF2},
+	"lab_f3":                    {CapKindString, LabF3},
+	"lf3":                       {CapKindString, LabF3},
+	"lab_f4":                    {CapKindString, LabF4},
+	"lf4":                       {CapKindString, LabF4},
+	"lab_f5":                    {CapKindString, LabF5},
+	"lf5":                       {CapKindString, LabF5},
+	"lab_f6":                    {CapKindString, LabF6},
+	"lf6":                       {CapKindString, LabF6},
+	"lab_f7":                    {CapKindString, LabF7},
+	"lf7":                       {CapKindString, LabF7},
+	"lab_f8":                    {CapKindString, LabF8},
+	"lf8":                       {CapKindString, LabF8},
+	"lab_f9":                    {CapKindString, LabF9},
+	"lf9":                       {CapKindString, LabF9},
+	"meta_off":                  {CapKindString, MetaOff},
+	"rmm":                       {CapKindString, MetaOff},
+	"meta_on":                   {CapKindString, MetaOn},
+	"smm":                       {CapKindString, MetaOn},
+	"newline":                   {CapKindString, Newline},
+	"nel":                       {CapKindString, Newline},
+	"pad_char":                  {CapKindString, PadChar},
+	"pad":                       {CapKindString, PadChar},
+	"parm_dch":                  {CapKindString, ParmDch},
+	"dch":                       {CapKindString, ParmDch},
+	"parm_delete_line":          {CapKindString, ParmDeleteLine},
+	"dl":                        {CapKindString, ParmDeleteLine},
+	"parm_down_cursor":          {CapKindString, ParmDownCursor},
+	"cud":                       {CapKindString, ParmDownCursor},
+	"parm_ich":                  {CapKindString, ParmIch},
+	"ich":                       {CapKindString, ParmIch},
+	"parm_index":                {CapKindString, ParmIndex},
+	"indn":                      {CapKindString, ParmIndex},
+	"parm_insert_line":          {CapKindString, ParmInsertLine},
+	"il":                        {CapKindString, ParmInsertLine},
+	"parm_left_cursor":          {CapKindString, ParmLeftCursor},
+	"cub":                       {CapKindString, ParmLeftCursor},
+	"parm_right_cursor":         {CapKindString, ParmRightCursor},
+	"cuf":                       {CapKindString, ParmRightCursor},
+	"parm_rindex":               {CapKindString, ParmRindex},
+	"rin":                       {CapKindString, ParmRindex},
+	"parm_up_cursor":            {CapKindString, ParmUpCursor},
+	"cuu":                       {CapKindString, ParmUpCursor},
+	"pkey_key":                  {CapKindString, PkeyKey},
+	"pfkey":                     {CapKindString, PkeyKey},
+	"pkey_local":                {CapKindString, PkeyLocal},
+	"pfloc":                     {CapKindString, PkeyLocal},
+	"pkey_xmit":                 {CapKindString, PkeyXmit},
+	"pfx":                       {CapKindString, PkeyXmit},
+	"print_screen":              {CapKindString, PrintScreen},
+	"mc0":                       {CapKindString, PrintScreen},
+	"prtr_off":                  {CapKindString, PrtrOff},
+	"mc4":                       {CapKindString, PrtrOff},
+	"prtr_on":                   {CapKindString, PrtrOn},
+	"mc5":                       {CapKindString, PrtrOn},
+	"repeat_char":               {CapKindString, RepeatChar},
+	"rep":                       {CapKindString, RepeatChar},
+	"reset_1string":             {CapKindString, Reset1string},
+	"rs1":                       {CapKindString, Reset1string},
+	"reset_2string":             {CapKindString, Reset2string},
+	"rs2":                       {CapKindString, Reset2string},
+	"reset_3string":             {CapKindString, Reset3string},
+	"rs3":                       {CapKindString, Reset3string},
+	"reset_file":                {CapKindString, ResetFile},
+	"rf":                        {CapKindString, ResetFile},
+	"restore_cursor":            {CapKindString, RestoreCursor},
+	"rc":                        {CapKindString, RestoreCursor},
+	"row_address":               {CapKindString, RowAddress},
+	"vpa":                       {CapKindString, RowAddress},
+	"save_cursor":               {CapKindString, SaveCursor},
+	"sc":                        {CapKindString, SaveCursor},
+	"scroll_forward":            {CapKindString, ScrollForward},
+	"ind":                       {CapKindString, ScrollForward},
+	"scroll_reverse":            {CapKindString, ScrollReverse},
+	"ri":                        {CapKindString, ScrollReverse},
+	"set_attributes":            {CapKindString, SetAttributes},
+	"sgr":                       {CapKindString, SetAttributes},
+	"set_tab":                   {CapKindString, SetTab},
+	"hts":                       {CapKindString, SetTab},
+	"set_window":                {CapKindString, SetWindow},
+	"wind":                      {CapKindString, SetWindow},
+	"tab":                       {CapKindString, Tab},
+	"ht":                        {CapKindString, Tab},
+	"to_status_line":            {CapKindString, ToStatusLine},
+	"tsl":                       {CapKindString, ToStatusLine},
+	"underline_char":            {CapKindString, UnderlineChar},
+	"uc":                        {CapKindString, UnderlineChar},
+	"up_half_line":              {CapKindString, UpHalfLine},
+	"hu":                        {CapKindString, UpHalfLine},
+	"init_prog":                 {CapKindString, InitProg},
+	"iprog":                     {CapKindString, InitProg},
+	"key_a1":                    {CapKindString, KeyA1},
+	"ka1":                       {CapKindString, KeyA1},
+	"key_a3":                    {CapKindString, KeyA3},
+	"ka3":                       {CapKindString, KeyA3},
+	"key_b2":                    {CapKindString, KeyB2},
+	"kb2":                       {CapKindString, KeyB2},
+	"key_c1":                    {CapKindString, KeyC1},
+	"kc1":                       {CapKindString, KeyC1},
+	"key_c3":                    {CapKindString, KeyC3},
+	"kc3":                       {CapKindString, KeyC3},
+	"prtr_non":                  {CapKindString, PrtrNon},
+	"mc5p":                      {CapKindString, PrtrNon},
+	"char_padding":              {CapKindString, CharPadding},
+	"rmp":                       {CapKindString, CharPadding},
+	"acs_chars":                 {CapKindString, AcsChars},
+	"acsc":                      {CapKindString, AcsChars},
+	"plab_norm":                 {CapKindString, PlabNorm},
+	"pln":                       {CapKindString, PlabNorm},
+	"key_btab":                  {CapKindString, KeyBtab},
+	"kcbt":                      {CapKindString, KeyBtab},
+	"enter_xon_mode":            {CapKindString, EnterXonMode},
+	"smxon":                     {CapKindString, EnterXonMode},
+	"exit_xon_mode":             {CapKindString, ExitXonMode},
+	"rmxon":                     {CapKindString, ExitXonMode},
+	"enter_am_mode":             {CapKindString, EnterAmMode},
+	"smam":                      {CapKindString, EnterAmMode},
+	"exit_am_mode":              {CapKindString, ExitAmMode},
+	"rmam":                      {CapKindString, ExitAmMode},
+	"xon_character":             {CapKindString, XonCharacter},
+	"xonc":                      {CapKindString, XonCharacter},
+	"xoff_character":            {CapKindString, XoffCharacter},
+	"xoffc":                     {CapKindString, XoffCharacter},
+	"ena_acs":                   {CapKindString, EnaAcs},
+	"enacs":                     {CapKindString, EnaAcs},
+	"label_on":                  {CapKindString, LabelOn},
+	"smln":                      {CapKindString, LabelOn},
+	"label_off":                 {CapKindString, LabelOff},
+	"rmln":                      {CapKindString, LabelOff},
+	"key_beg":                   {CapKindString, KeyBeg},
+	"kbeg":                      {CapKindString, KeyBeg},
+	"key_cancel":                {CapKindString, KeyCancel},
+	"kcan":                      {CapKindString, KeyCancel},
+	"key_close":                 {CapKindString, KeyClose},
+	"kclo":                      {CapKindString, KeyClose},
+	"key_command":               {CapKindString, KeyCommand},
+	"kcmd":                      {CapKindString, KeyCommand},
+	"key_copy":                  {CapKindString, KeyCopy},
+	"kcpy":                      {CapKindString, KeyCopy},
+	"key_create":                {CapKindString, KeyCreate},
+	"kcrt":                      {CapKindString, KeyCreate},
+	"key_end":                   {CapKindString, KeyEnd},
+	"kend":                      {CapKindString, KeyEnd},
+	"key_enter":                 {CapKindString, KeyEnter},
+	"kent":                      {CapKindString, KeyEnter},
+	"key_exit":                  {CapKindString, KeyExit},
+	"kext":                      {CapKindString, KeyExit},
+	"key_find":                  {CapKindString, KeyFind},
+	"kfnd":                      {CapKindString, KeyFind},
+	"key_help":                  {CapKindString, KeyHelp},
+	"khlp":                      {CapKindString, KeyHelp},
+	"key_mark":                  {CapKindString, KeyMark},
+	"kmrk":                      {CapKindString, KeyMark},
+	"key_message":               {CapKindString, KeyMessage},
+	"kmsg":                      {CapKindString, KeyMessage},
+	"key_move":                  {CapKindString, KeyMove},
+	"kmov":                      {CapKindString, KeyMove},
+	"key_next":                  {CapKindString, KeyNext},
+	"knxt":                      {CapKindString, KeyNext},
+	"key_open":                  {CapKindString, KeyOpen},
+	"kopn":                      {CapKindString, KeyOpen},
+	"key_options":               {CapKindString, KeyOptions},
+	"kopt":                      {CapKindString, KeyOptions},
+	"key_previous":              {CapKindString, KeyPrevious},
+	"kprv":                      {CapKindString, KeyPrevious},
+	"key_print":                 {CapKindString, KeyPrint},
+	"kprt":                      {CapKindString, KeyPrint},
+	"key_redo":                  {CapKindString, KeyRedo},
+	"krdo":                      {CapKindString, KeyRedo},
+	"key_reference":             {CapKindString, KeyReference},
+	"kref":                      {CapKindString, KeyReference},
+	"key_refresh":               {CapKindString, KeyRefresh},
+	"krfr":                      {CapKindString, KeyRefresh},
+	"key_replace":               {CapKindString, KeyReplace},
+	"krpl":                      {CapKindString, KeyReplace},
+	"key_restart":               {CapKindString, KeyRestart},
+	"krst":                      {CapKindString, KeyRestart},
+	"key_resume":                {CapKindString, KeyResume},
+	"kres":                      {CapKindString, KeyResume},
+	"key_save":                  {CapKindString, KeySave},
+	"ksav":                      {CapKindString, KeySave},
+	"key_suspend":               {CapKindString, KeySuspend},
+	"kspd":                      {CapKindString, KeySuspend},
+	"key_undo":                  {CapKindString, KeyUndo},
+	"kund":                      {CapKindString, KeyUndo},
+	"key_sbeg":                  {CapKindString, KeySbeg},
+	"kBEG":                      {CapKindString, KeySbeg},
+	"key_scancel":               {CapKindString, KeyScancel},
+	"kCAN":                      {CapKindString, KeyScancel},
+	"key_scommand":              {CapKindString, KeyScommand},
+	"kCMD":                      {CapKindString, KeyScommand},
+	"key_scopy":                 {CapKindString, KeyScopy},
+	"kCPY":                      {CapKindString, KeyScopy},
+	"key_screate":               {CapKindString, KeyScreate},
+	"kCRT":                      {CapKindString, KeyScreate},
+	"key_sdc":                   {CapKindString, KeySdc},
+	"kDC":                       {CapKindString, KeySdc},
+	"key_sdl":                   {CapKindString, KeySdl},
+	"kDL":                       {CapKindString, KeySdl},
+	"key_select":                {CapKindString, KeySelect},
+	"kslt":                      {CapKindString, KeySelect},
+	"key_send":                  {CapKindString, KeySend},
+	"kEND":                      {CapKindString, KeySend},
+	"key_seol":                  {CapKindString, KeySeol},
+	"kEOL":                      {CapKindString, KeySeol},
+	"key_sexit":                 {CapKindString, KeySexit},
+	"kEXT":                      {CapKindString, KeySexit},
+	"key_sfind":                 {CapKindString, KeySfind},
+	"kFND":                      {CapKindString, KeySfind},
+	"key_shelp":                 {CapKindString, KeyShelp},
+	"kHLP":                      {CapKindString, KeyShelp},
+	"key_shome":                 {CapKindString, KeyShome},
+	"kHOM":                      {CapKindString, KeyShome},
+	"key_sic":                   {CapKindString, KeySic},
+	"kIC":                       {CapKindString, KeySic},
+	"key_sleft":                 {CapKindString, KeySleft},
+	"kLFT":                      {CapKindString, KeySleft},
+	"key_smessage":              {CapKindString, KeySmessage},
+	"kMSG":                      {CapKindString, KeySmessage},
+	"key_smove":                 {CapKindString, KeySmove},
+	"kMOV":                      {CapKindString, KeySmove},
+	"key_snext":                 {CapKindString, KeySnext},
+	"kNXT":                      {CapKindString, KeySnext},
+	"key_soptions":              {CapKindString, KeySoptions},
+	"kOPT":                      {CapKindString, KeySoptions},
+	"key_sprevious":             {CapKindString, KeySprevious},
+	"kPRV":                      {CapKindString, KeySprevious},
+	"key_sprint":                {CapKindString, KeySprint},
+	"kPRT":                      {CapKindString, KeySprint},
+	"key_sredo":                 {CapKindString, KeySredo},
+	"kRDO":                      {CapKindString, KeySredo},
+	"key_sreplace":              {CapKindString, KeySreplace},
+	"kRPL":                      {CapKindString, KeySreplace},
+	"key_sright":                {CapKindString, KeySright},
+	"kRIT":                      {CapKindString, KeySright},
+	"key_srsume":                {CapKindString, KeySrsume},
+	"kRES":                      {CapKindString, KeySrsume},
+	"key_ssave":                 {CapKindString, KeySsave},
+	"kSAV":                      {CapKindString, KeySsave},
+	"key_ssuspend":              {CapKindString, KeySsuspend},
+	"kSPD":                      {CapKindString, KeySsuspend},
+	"key_sundo":                 {CapKindString, KeySundo},
+	"kUND":                      {CapKindString, KeySundo},
+	"req_for_input":             {CapKindString, ReqForInput},
+	"rfi":                       {CapKindString, ReqForInput},
+	"key_f11":                   {CapKindString, KeyF11},
+	"kf11":                      {CapKindString, KeyF11},
+	"key_f12":                   {CapKindString, KeyF12},
+	"kf12":                      {CapKindString, KeyF12},
+	"key_f13":                   {CapKindString, KeyF13},
+	"kf13":                      {CapKindString, KeyF13},
+	"key_f14":                   {CapKindString, KeyF14},
+	"kf14":                      {CapKindString, KeyF14},
+	"key_f15":                   {CapKindString, KeyF15},
+	"kf15":                      {CapKindString, KeyF15},
+	"key_f16":                   {CapKindString, KeyF16},
+	"kf16":                      {CapKindString, KeyF16},
+	"key_f17":                   {CapKindString, KeyF17},
+	"kf17":                      {CapKindString, KeyF17},
+	"key_f18":                   {CapKindString, KeyF18},
+	"kf18":                      {CapKindString, KeyF18},
+	"key_f19":                   {CapKindString, KeyF19},
+	"kf19":                      {CapKindString, KeyF19},
+	"key_f20":                   {CapKindString, KeyF20},
+	"kf20":                      {CapKindString, KeyF20},
+	"key_f21":                   {CapKindString, KeyF21},
+	"kf21":                      {CapKindString, KeyF21},
+	"key_f22":                   {CapKindString, KeyF22},
+	"kf22":                      {CapKindString, KeyF22},
+	"key_f23":                   {CapKindString, KeyF23},
+	"kf23":                      {CapKindString, KeyF23},
+	"key_f24":                   {CapKindString, KeyF24},
+	"kf24":                      {CapKindString, KeyF24},
+	"key_f25":                   {CapKindString, KeyF25},
+	"kf25":                      {CapKindString, KeyF25},
+	"key_f26":                   {CapKindString, KeyF26},
+	"kf26":                      {CapKindString, KeyF26},
+	"key_f27":                   {CapKindString, KeyF27},
+	"kf27":                      {CapKindString, KeyF27},
+	"key_f28":                   {CapKindString, KeyF28},
+	"kf28":                      {CapKindString, KeyF28},
+	"key_f29":                   {CapKindString, KeyF29},
+	"kf29":                      {CapKindString, KeyF29},
+	"key_f30":                   {CapKindString, KeyF30},
+	"kf30":                      {CapKindString, KeyF30},
+	"key_f31":                   {CapKindString, KeyF31},
+	"kf31":                      {CapKindString, KeyF31},
+	"key_f32":                   {CapKindString, KeyF32},
+	"kf32":                      {CapKindString, KeyF32},
+	"key_f33":                   {CapKindString, KeyF33},
+	"kf33":                      {CapKindString, KeyF33},
+	"key_f34":                   {CapKindString, KeyF34},
+	"kf34":                      {CapKindString, KeyF34},
+	"key_f35":                   {CapKindString, KeyF35},
+	"kf35":                      {CapKindString, KeyF35},
+	"key_f36":                   {CapKindString, KeyF36},
+	"kf36":                      {CapKindString, KeyF36},
+	"key_f37":                   {CapKindString, KeyF37},
+	"kf37":                      {CapKindString, KeyF37},
+	"key_f38":                   {CapKindString, KeyF38},
+	"kf38":                      {CapKindString, KeyF38},
+	"key_f39":                   {CapKindString, KeyF39},
+	"kf39":                      {CapKindString, KeyF39},
+	"key_f40":                   {CapKindString, KeyF40},
+	"kf40":                      {CapKindString, KeyF40},
+	"key_f41":                   {CapKindString, KeyF41},
+	"kf41":                      {CapKindString, KeyF41},
+	"key_f42":                   {CapKindString, KeyF42},
+	"kf42":                      {CapKindString, KeyF42},
+	"key_f43":                   {CapKindString, KeyF43},
+	"kf43":                      {CapKindString, KeyF43},
+	"key_f44":                   {CapKindString, KeyF44},
+	"kf44":                      {CapKindString, KeyF44},
+	"key_f45":                   {CapKindString, KeyF45},
+	"kf45":                      {CapKindString, KeyF45},
+	"key_f46":                   {CapKindString, KeyF46},
+	"kf46":                      {CapKindString, KeyF46},
+	"key_f47":                   {CapKindString, KeyF47},
+	"kf47":                      {CapKindString, KeyF47},
+	"key_f48":                   {CapKindString, KeyF48},
+	"kf48":                      {CapKindString, KeyF48},
+	"key_f49":                   {CapKindString, KeyF49},
+	"kf49":                      {CapKindString, KeyF49},
+	"key_f50":                   {CapKindString, KeyF50},
+	"kf50":                      {CapKindString, KeyF50},
+	"key_f51":                   {CapKindString, KeyF51},
+	"kf51":                      {CapKindString, KeyF51},
+	"key_f52":                   {CapKindString, KeyF52},
+	"kf52":                      {CapKindString, KeyF52},
+	"key_f53":                   {CapKindString, KeyF53},
+	"kf53":                      {CapKindString, KeyF53},
+	"key_f54":                   {CapKindString, KeyF54},
+	"kf54":                      {CapKindString, KeyF54},
+	"key_f55":                   {CapKindString, KeyF55},
+	"kf55":                      {CapKindString, KeyF55},
+	"key_f56":                   {CapKindString, KeyF56},
+	"kf56":                      {CapKindString, KeyF56},
+	"key_f57":                   {CapKindString, KeyF57},
+	"kf57":                      {CapKindString, KeyF57},
+	"key_f58":                   {CapKindString, KeyF58},
+	"kf58":                      {CapKindString, KeyF58},
+	"key_f59":                   {CapKindString, KeyF59},
+	"kf59":                      {CapKindString, KeyF59},
+	"key_f60":                   {CapKindString, KeyF60},
+	"kf60":                      {CapKindString, KeyF60},
+	"key_f61":                   {CapKindString, KeyF61},
+	"kf61":                      {CapKindString, KeyF61},
+	"key_f62":                   {CapKindString, KeyF62},
+	"kf62":                      {CapKindString, KeyF62},
+	"key_f63":                   {CapKindString, KeyF63},
+	"kf63":                      {CapKindString, KeyF63},
+	"clr_bol":                   {CapKindString, ClrBol},
+	"el1":                       {CapKindString, ClrBol},
+	"clear_margins":             {CapKindString, ClearMargins},
+	"mgc":                       {CapKindString, ClearMargins},
+	"set_left_margin":           {CapKindString, SetLeftMargin},
+	"smgl":                      {CapKindString, SetLeftMargin},
+	"set_right_margin":          {CapKindString, SetRightMargin},
+	"smgr":                      {CapKindString, SetRightMargin},
+	"label_format":              {CapKindString, LabelFormat},
+	"fln":                       {CapKindString, LabelFormat},
+	"set_clock":                 {CapKindString, SetClock},
+	"sclk":                      {CapKindString, SetClock},
+	"display_clock":             {CapKindString, DisplayClock},
+	"dclk":                      {CapKindString, DisplayClock},
+	"remove_clock":              {CapKindString, RemoveClock},
+	"rmclk":                     {CapKindString, RemoveClock},
+	"create_window":             {CapKindString, CreateWindow},
+	"cwin":                      {CapKindString, CreateWindow},
+	"goto_window":               {CapKindString, GotoWindow},
+	"wingo":                     {CapKindString, GotoWindow},
+	"hangup":                    {CapKindString, Hangup},
+	"hup":                       {CapKindString, Hangup},
+	"dial_phone":                {CapKindString, DialPhone},
+	"dial":                      {CapKindString, DialPhone},
+	"quick_dial":                {CapKindString, QuickDial},
+	"qdial":                     {CapKindString, QuickDial},
+	"tone":                      {CapKindString, Tone},
+	"pulse":                     {CapKindString, Pulse},
+	"flash_hook":                {CapKindString, FlashHook},
+	"hook":                      {CapKindString, FlashHook},
+	"fixed_pause":               {CapKindString, FixedPause},
+	"pause":                     {CapKindString, FixedPause},
+	"wait_tone":                 {CapKindString, WaitTone},
+	"wait":                      {CapKindString, WaitTone},
+	"user0":                     {CapKindString, User0},
+	"u0":                        {CapKindString, User0},
+	"user1":                     {CapKindString, User1},
+	"u1":                        {CapKindString, User1},
+	"user2":                     {CapKindString, User2},
+	"u2":                        {CapKindString, User2},
+	"user3":                     {CapKindString, User3},
+	"u3":                        {CapKindString, User3},
+	"user4":                     {CapKindString, User4},
+	"u4":                        {CapKindString, User4},
+	"user5":                     {CapKindString, User5},
+	"u5":                        {CapKindString, User5},
+	"user6":                     {CapKindString, User6},
+	"u6":                        {CapKindString, User6},
+	"user7":                     {CapKindString, User7},
+	"u7":                        {CapKindString, User7},
+	"user8":                     {CapKindString, User8},
+	"u8":                        {CapKindString, User8},
+	"user9":                     {CapKindString, User9},
+	"u9":                        {CapKindString, User9},
+	"orig_pair":                 {CapKindString, OrigPair},
+	"op":                        {CapKindString, OrigPair},
+	"orig_colors":               {CapKindString, OrigColors},
+	"oc":                        {CapKindString, OrigColors},
+	"initialize_color":          {CapKindString, InitializeColor},
+	"initc":                     {CapKindString, InitializeColor},
+	"initialize_pair":           {CapKindString, InitializePair},
+	"initp":                     {CapKindString, InitializePair},
+	"set_color_pair":            {CapKindString, SetColorPair},
+	"scp":                       {CapKindString, SetColorPair},
+	"set_foreground":            {CapKindString, SetForeground},
+	"setf":                      {CapKindString, SetForeground},
+	"set_background":            {CapKindString, SetBackground},
+	"setb":                      {CapKindString, SetBackground},
+	"change_char_pitch":         {CapKindString, ChangeCharPitch},
+	"cpi":                       {CapKindString, ChangeCharPitch},
+	"change_line_pitch":         {CapKindString, ChangeLinePitch},
+	"lpi":                       {CapKindString, ChangeLinePitch},
+	"change_res_horz":           {CapKindString, ChangeResHorz},
+	"chr":                       {CapKindString, ChangeResHorz},
+	"change_res_vert":           {CapKindString, ChangeResVert},
+	"cvr":                       {CapKindString, ChangeResVert},
+	"define_char":               {CapKindString, DefineChar},
+	"defc":                      {CapKindString, DefineChar},
+	"enter_doublewide_mode":     {CapKindString, EnterDoublewideMode},
+	"swidm":                     {CapKindString, EnterDoublewideMode},
+	"enter_draft_quality":       {CapKindString, EnterDraftQuality},
+	"sdrfq":                     {CapKindString, EnterDraftQuality},
+	"enter_italics_mode":        {CapKindString, EnterItalicsMode},
+	"sitm":                      {CapKindString, EnterItalicsMode},
+	"enter_leftward_mode":       {CapKindString, EnterLeftwardMode},
+	"slm":                       {CapKindString, EnterLeftwardMode},
+	"enter_micro_mode":          {CapKindString, EnterMicroMode},
+	"smicm":                     {CapKindString, EnterMicroMode},
+	"enter_near_letter_quality": {CapKindString, EnterNearLetterQuality},
+	"snlq":                      {CapKindString, EnterNearLetterQuality},
+	"enter_normal_quality":      {CapKindString, EnterNormalQuality},
+	"snrmq":                     {CapKindString, EnterNormalQuality},
+	"enter_shadow_mode":         {CapKindString, EnterShadowMode},
+	"sshm":                      {CapKindString, EnterShadowMode},
+	"enter_subscript_mode":      {CapKindString, EnterSubscriptMode},
+	"ssubm":                     {CapKindString, EnterSubscriptMode},
+	"enter_superscript_mode":    {CapKindString, EnterSuperscriptMode},
+	"ssupm":                     {CapKindString, EnterSuperscriptMode},
+	"enter_upward_mode":         {CapKindString, EnterUpwardMode},
+	"sum":                       {CapKindString, EnterUpwardMode},
+	"exit_doublewide_mode":      {CapKindString, ExitDoublewideMode},
+	"rwidm":                     {CapKindString, ExitDoublewideMode},
+	"exit_italics_mode":         {CapKindString, ExitItalicsMode},
+	"ritm":                      {CapKindString, ExitItalicsMode},
+	"exit_leftward_mode":        {CapKindString, ExitLeftwardMode},
+	"rlm":                       {CapKindString, ExitLeftwardMode},
+	"exit_micro_mode":           {CapKindString, ExitMicroMode},
+	"rmicm":                     {CapKindString, ExitMicroMode},
+	"exit_shadow_mode":          {CapKindString, ExitShadowMode},
+	"rshm":                      {CapKindString, ExitShadowMode},
+	"exit_subscript_mode":       {CapKindString, ExitSubscriptMode},
+	"rsubm":                     {CapKindString, ExitSubscriptMode},
+	"exit_superscript_mode":     {CapKindString, ExitSuperscriptMode},
+	"rsupm":                     {CapKindString, ExitSuperscriptMode},
+	"exit_upward_mode":          {CapKindString, ExitUpwardMode},
+	"rum":                       {CapKindString, ExitUpwardMode},
+	"micro_column_address":      {CapKindString, MicroColumnAddress},
+	"mhpa":                      {CapKindString, MicroColumnAddress},
+	"micro_down":                {CapKindString, MicroDown},
+	"mcud1":                     {CapKindString, MicroDown},
+	"micro_left":                {CapKindString, MicroLeft},
+	"mcub1":                     {CapKindString, MicroLeft},
+	"micro_right":               {CapKindString, MicroRight},
+	"mcuf1":                     {CapKindString, MicroRight},
+	"micro_row_address":         {CapKindString, MicroRowAddress},
+	"mvpa":                      {CapKindString, MicroRowAddress},
+	"micro_up":                  {CapKindString, MicroUp},
+	"mcuu1":                     {CapKindString, MicroUp},
+	"order_of_pins":             {CapKindString, OrderOfPins},
+	"porder":                    {CapKindString, OrderOfPins},
+	"parm_down_micro":           {CapKindString, ParmDownMicro},
+	"mcud":                      {CapKindString, ParmDownMicro},
+	"parm_left_micro":           {CapKindString, ParmLeftMicro},
+	"mcub":                      {CapKindString, ParmLeftMicro},
+	"parm_right_micro":          {CapKindString, ParmRightMicro},
+	"mcuf":                      {CapKindString, ParmRightMicro},
+	"parm_up_micro":             {CapKindString, ParmUpMicro},
+	"mcuu":                      {CapKindString, ParmUpMicro},
+	"select_char_set":           {CapKindString, SelectCharSet},
+	"scs":                       {CapKindString, SelectCharSet},
+	"set_bottom_margin":         {CapKindString, SetBottomMargin},
+	"smgb":                      {CapKindString, SetBottomMargin},
+	"set_bottom_margin_parm":    {CapKindString, SetBottomMarginParm},
+	"smgbp":                     {CapKindString, SetBottomMarginParm},
+	"set_left_margin_parm":      {CapKindString, SetLeftMarginParm},
+	"smglp":                     {CapKindString, SetLeftMarginParm},
+	"set_right_margin_parm":     {CapKindString, SetRightMarginParm},
+	"smgrp":                     {CapKindString, SetRightMarginParm},
+	"set_top_margin":            {CapKindString, SetTopMargin},
+	"smgt":                      {CapKindString, SetTopMargin},
+	"set_top_margin_parm":       {CapKindString, SetTopMarginParm},
+	"smgtp":                     {CapKindString, SetTopMarginParm},
+	"start_bit_image":           {CapKindString, StartBitImage},
+	"sbim":                      {CapKindString, StartBitImage},
+	"start_char_set_def":        {CapKindString, StartCharSetDef},
+	"scsd":                      {CapKindString, StartCharSetDef},
+	"stop_bit_image":            {CapKindString, StopBitImage},
+	"rbim":                      {CapKindString, StopBitImage},
+	"stop_char_set_def":         {CapKindString, StopCharSetDef},
+	"rcsd":                      {CapKindString, StopCharSetDef},
+	"subscript_characters":      {CapKindString, SubscriptCharacters},
+	"subcs":                     {CapKindString, SubscriptCharacters},
+	"superscript_characters":    {CapKindString, SuperscriptCharacters},
+	"supcs":                     {CapKindString, SuperscriptCharacters},
+	"these_cause_cr":            {CapKindString, TheseCauseCr},
+	"docr":                      {CapKindString, TheseCauseCr},
+	"zero_motion":               {CapKindString, ZeroMotion},
+	"zerom":                     {CapKindString, ZeroMotion},
+	"char_set_names":            {CapKindString, CharSetNames},
+	"csnm":                      {CapKindString, CharSetNames},
+	"key_mouse":                 {CapKindString, KeyMouse},
+	"kmous":                     {CapKindString, KeyMouse},
+	"mouse_info":                {CapKindString, MouseInfo},
+	"minfo":                     {CapKindString, MouseInfo},
+	"req_mouse_pos":             {CapKindString, ReqMousePos},
+	"reqmp":                     {CapKindString, ReqMousePos},
+	"get_mouse":                 {CapKindString, GetMouse},
+	"getm":                      {CapKindString, GetMouse},
+	"set_a_foreground":          {CapKindString, SetAForeground},
+	"setaf":                     {CapKindString, SetAForeground},
+	"set_a_background":          {CapKindString, SetABackground},
+	"setab":                     {CapKindString, SetABackground},
+	"pkey_plab":                 {CapKindString, PkeyPlab},
+	"pfxl":                      {CapKindString, PkeyPlab},
+	"device_type":               {CapKindString, DeviceType},
+	"devt":                      {CapKindString, DeviceType},
+	"code_set_init":             {CapKindString, CodeSetInit},
+	"csin":                      {CapKindString, CodeSetInit},
+	"set0_des_seq":              {CapKindString, Set0DesSeq},
+	"s0ds":                      {CapKindString, Set0DesSeq},
+	"set1_des_seq":              {CapKindString, Set1DesSeq},
+	"s1ds":                      {CapKindString, Set1DesSeq},
+	"set2_des_seq":              {CapKindString, Set2DesSeq},
+	"s2ds":                      {CapKindString, Set2DesSeq},
+	"set3_des_seq":              {CapKindString, Set3DesSeq},
+	"s3ds":                      {CapKindString, Set3DesSeq},
+	"set_lr_margin":             {CapKindString, SetLrMargin},
+	"smglr":                     {CapKindString, SetLrMargin},
+	"set_tb_margin":             {CapKindString, SetTbMargin},
+	"smgtb":                     {CapKindString, SetTbMargin},
+	"bit_image_repeat":          {CapKindString, BitImageRepeat},
+	"birep":                     {CapKindString, BitImageRepeat},
+	"bit_image_newline":         {CapKindString, BitImageNewline},
+	"binel":                     {CapKindString, BitImageNewline},
+	"bit_image_carriage_return": {CapKindString, BitImageCarriageReturn},
+	"bicr":                      {CapKindString, BitImageCarriageReturn},
+	"color_names":               {CapKindString, ColorNames},
+	"colornm":                   {CapKindString, ColorNames},
+	"define_bit_image_region":   {CapKindString, DefineBitImageRegion},
+	"defbi":                     {CapKindString, DefineBitImageRegion},
+	"end_bit_image_region":      {CapKindString, EndBitImageRegion},
+	"endbi":                     {CapKindString, EndBitImageRegion},
+	"set_color_band":            {CapKindString, SetColorBand},
+	"setcolor":                  {CapKindString, SetColorBand},
+	"set_page_length":           {CapKindString, SetPageLength},
+	"slines":                    {CapKindString, SetPageLength},
+	"display_pc_char":           {CapKindString, DisplayPcChar},
+	"dispc":                     {CapKindString, DisplayPcChar},
+	"enter_pc_charset_mode":     {CapKindString, EnterPcCharsetMode},
+	"smpch":                     {CapKindString, EnterPcCharsetMode},
+	"exit_pc_charset_mode":      {CapKindString, ExitPcCharsetMode},
+	"rmpch":                     {CapKindString, ExitPcCharsetMode},
+	"enter_scancode_mode":       {CapKindString, EnterScancodeMode},
+	"smsc":                      {CapKindString, EnterScancodeMode},
+	"exit_scancode_mode":        {CapKindString, ExitScancodeMode},
+	"rmsc":                      {CapKindString, ExitScancodeMode},
+	"pc_term_options":           {CapKindString, PcTermOptions},
+	"pctrm":                     {CapKindString, PcTermOptions},
+	"scancode_escape":           {CapKindString, ScancodeEscape},
+	"scesc":                     {CapKindString, ScancodeEscape},
+	"alt_scancode_esc":          {CapKindString, AltScancodeEsc},
+	"scesa":                     {CapKindString, AltScancodeEsc},
+	"enter_horizontal_hl_mode":  {CapKindString, EnterHorizontalHlMode},
+	"ehhlm":                     {CapKindString, EnterHorizontalHlMode},
+	"enter_left_hl_mode":        {CapKindString, EnterLeftHlMode},
+	"elhlm":                     {CapKindString, EnterLeftHlMode},
+	"enter_low_hl_mode":         {CapKindString, EnterLowHlMode},
+	"elohlm":                    {CapKindString, EnterLowHlMode},
+	"enter_right_hl_mode":       {CapKindString, EnterRightHlMode},
+	"erhlm":                     {CapKindString, EnterRightHlMode},
+	"enter_top_hl_mode":         {CapKindString, EnterTopHlMode},
+	"ethlm":                     {CapKindString, EnterTopHlMode},
+	"enter_vertical_hl_mode":    {CapKindString, EnterVerticalHlMode},
+	"evhlm":                     {CapKindString, EnterVerticalHlMode},
+	"set_a_attributes":          {CapKindString, SetAAttributes},
+	"sgr1":                      {CapKindString, SetAAttributes},
+	"set_pglen_inch":            {CapKindString, SetPglenInch},
+	"slength":                   {CapKindString, SetPglenInch},
+	"termcap_init2":             {CapKindString, TermcapInit2},
+	"OTi2":                      {CapKindString, TermcapInit2},
+	"termcap_reset":             {CapKindString, TermcapReset},
+	"OTrs":                      {CapKindString, TermcapReset},
+	"linefeed_if_not_lf":        {CapKindString, LinefeedIfNotLf},
+	"OTnl":                      {CapKindString, LinefeedIfNotLf},
+	"backspace_if_not_bs":       {CapKindString, BackspaceIfNotBs},
+	"OTbc":                      {CapKindString, BackspaceIfNotBs},
+	"other_non_function_keys":   {CapKindString, OtherNonFunctionKeys},
+	"OTko":                      {CapKindString, OtherNonFunctionKeys},
+	"arrow_key_map":             {CapKindString, ArrowKeyMap},
+	"OTma":                      {CapKindString, ArrowKeyMap},
+	"acs_ulcorner":              {CapKindString, AcsUlcorner},
+	"OTG2":                      {CapKindString, AcsUlcorner},
+	"acs_llcorner":              {CapKindString, AcsLlcorner},
+	"OTG3":                      {CapKindString, AcsLlcorner},
+	"acs_urcorner":              {CapKindString, AcsUrcorner},
+	"OTG1":                      {CapKindString, AcsUrcorner},
+	"acs_lrcorner":              {CapKindString, AcsLrcorner},
+	"OTG4":                      {CapKindString, AcsLrcorner},
+	"acs_ltee":                  {CapKindString, AcsLtee},
+	"OTGR":                      {CapKindString, AcsLtee},
+	"acs_rtee":                  {CapKindString, AcsRtee},
+	"OTGL":                      {CapKindString, AcsRtee},
+	"acs_btee":                  {CapKindString, AcsBtee},
+	"OTGU":                      {CapKindString, AcsBtee},
+	"acs_ttee":                  {CapKindString, AcsTtee},
+	"OTGD":                      {CapKindString, AcsTtee},
+	"acs_hline":                 {CapKindString, AcsHline},
+	"OTGH":                      {CapKindString, AcsHline},
+	"acs_vline":                 {CapKindString, AcsVline},
+	"OTGV":                      {CapKindString, AcsVline},
+	"acs_plus":                  {CapKindString, AcsPlus},
+	"OTGC":                      {CapKindString, AcsPlus},
+	"memory_lock":               {CapKindString, MemoryLock},
+	"meml":                      {CapKindString, MemoryLock},
+	"memory_unlock":             {CapKindString, MemoryUnlock},
+	"memu":                      {CapKindString, MemoryUnlock},
+	"box_chars_1":               {CapKindString, BoxChars1},
+	"box1":                      {CapKindString, BoxChars1},
+}
+
+// termcapNames are the termcap names mapped to their kind and index.
+var termcapNames = map[string]capIndex{
+	"bw": {CapKindBool, AutoLeftMargin},
+	"am": {CapKindBool, AutoRightMargin},
+	"xb": {CapKindBool, NoEscCtlc},
+	"xs": {CapKindBool, CeolStandoutGlitch},
+	"xn": {CapKindBool, EatNewlineGlitch},
+	"eo": {CapKindBool, EraseOverstrike},
+	"gn": {CapKindBool, GenericType},
+	"hc": {CapKindBool, HardCopy},
+	"km": {CapKindBool, HasMetaKey},
+	"hs": {CapKindBool, HasStatusLine},
+	"in": {CapKindBool, InsertNullGlitch},
+	"da": {CapKindBool, MemoryAbove},
+	"db": {CapKindBool, MemoryBelow},
+	"mi": {CapKindBool, MoveInsertMode},
+	"ms": {CapKindBool, MoveStandoutMode},
+	"os": {CapKindBool, OverStrike},
+	"es": {CapKindBool, StatusLineEscOk},
+	"xt": {CapKindBool, DestTabsMagicSmso},
+	"hz": {CapKindBool, TildeGlitch},
+	"ul": {CapKindBool, TransparentUnderline},
+	"xo": {CapKindBool, XonXoff},
+	"nx": {CapKindBool, NeedsXonXoff},
+	"5i": {CapKindBool, PrtrSilent},
+	"HC": {CapKindBool, HardCursor},
+	"NR": {CapKindBool, NonRevRmcup},
+	"NP": {CapKindBool, NoPadChar},
+	"ND": {CapKindBool, NonDestScrollRegion},
+	"cc": {CapKindBool, CanChange},
+	"ut": {CapKindBool, BackColorErase},
+	"hl": {CapKindBool, HueLightnessSaturation},
+	"YA": {CapKindBool, ColAddrGlitch},
+	"YB": {CapKindBool, CrCancelsMicroMode},
+	"YC": {CapKindBool, HasPrintWheel},
+	"YD": {CapKindBool, RowAddrGlitch},
+	"YE": {CapKindBool, SemiAutoRightMargin},
+	"YF": {CapKindBool, CpiChangesRes},
+	"YG": {CapKindBool, LpiChangesRes},
+	"bs": {CapKindBool, BackspacesWithBs},
+	"ns": {CapKindBool, CrtNoScrolling},
+	"nc": {CapKindBool, NoCorrectlyWorkingCr},
+	"MT": {CapKindBool, GnuHasMetaKey},
+	"NL": {CapKindBool, LinefeedIsNewline},
+	"pt": {CapKindBool, HasHardwareTabs},
+	"xr": {CapKindBool, ReturnDoesClrEol},
+	"co": {CapKindNum, Columns},
+	"it": {CapKindNum, InitTabs},
+	"li": {CapKindNum, Lines},
+	"lm": {CapKindNum, LinesOfMemory},
+	"sg": {CapKindNum, MagicCookieGlitch},
+	"pb": {CapKindNum, PaddingBaudRate},
+	"vt": {CapKindNum, VirtualTerminal},
+	"ws": {CapKindNum, WidthStatusLine},
+	"Nl": {CapKindNum, NumLabels},
+	"lh": {CapKindNum, LabelHeight},
+	"lw": {CapKindNum, LabelWidth},
+	"ma": {CapKindNum, MaxAttributes},
+	"MW": {CapKindNum, MaximumWindows},
+	"Co": {CapKindNum, MaxColors},
+	"pa": {CapKindNum, MaxPairs},
+	"NC": {CapKindNum, NoColorVideo},
+	"Ya": {CapKindNum, BufferCapacity},
+	"Yb": {CapKindNum, DotVertSpacing},
+	"Yc": {CapKindNum, DotHorzSpacing},
+	"Yd": {CapKindNum, MaxMicroAddress},
+	"Ye": {CapKindNum, MaxMicroJump},
+	"Yf": {CapKindNum, MicroColSize},
+	"Yg": {CapKindNum, MicroLineSize},
+	"Yh": {CapKindNum, NumberOfPins},
+	"Yi": {CapKindNum, OutputResChar},
+	"Yj": {CapKindNum, OutputResLine},
+	"Yk": {CapKindNum, OutputResHorzInch},
+	"Yl": {CapKindNum, OutputResVertInch},
+	"Ym": {CapKindNum, PrintRate},
+	"Yn": {CapKindNum, WideCharSize},
+	"BT": {CapKindNum, Buttons},
+	"Yo": {CapKindNum, BitImageEntwining},
+	"Yp": {CapKindNum, BitImageType},
+	"ug": {CapKindNum, MagicCookieGlitchUl},
+	"dC": {CapKindNum, CarriageReturnDelay},
+	"dN": {CapKindNum, NewLineDelay},
+	"dB": {CapKindNum, BackspaceDelay},
+	"dT": {CapKindNum, HorizontalTabDelay},
+	"kn": {CapKindNum, NumberOfFunctionKeys},
+	"bt": {CapKindString, BackTab},
+	"bl": {CapKindString, Bell},
+	"cr": {CapKindString, CarriageReturn},
+	"cs": {CapKindString, ChangeScrollRegion},
+	"ct": {CapKindString, ClearAllTabs},
+	"cl": {CapKindString, ClearScreen},
+	"ce": {CapKindString, ClrEol},
+	"cd": {CapKindString, ClrEos},
+	"ch": {CapKindString, ColumnAddress},
+	"CC": {CapKindString, CommandCharacter},
+	"cm": {CapKindString, CursorAddress},
+	"do": {CapKindString, CursorDown},
+	"ho": {CapKindString, CursorHome},
+	"vi": {CapKindString, CursorInvisible},
+	"le": {CapKindString, CursorLeft},
+	"CM": {CapKindString, CursorMemAddress},
+	"ve": {CapKindString, CursorNormal},
+	"nd": {CapKindString, CursorRight},
+	"ll": {CapKindString, CursorToLl},
+	"up": {CapKindString, CursorUp},
+	"vs": {CapKindString, CursorVisible},
+	"dc": {CapKindString, DeleteCharacter},
+	"dl": {CapKindString, DeleteLine},
+	"ds": {CapKindString, DisStatusLine},
+	"hd": {CapKindString, DownHalfLine},
+	"as": {CapKindString, EnterAltCharsetMode},
+	"mb": {CapKindString, EnterBlinkMode},
+	"md": {CapKindString, EnterBoldMode},
+	"ti": {CapKindString, EnterCaMode},
+	"dm": {CapKindString, EnterDeleteMode},
+	"mh": {CapKindString, EnterDimMode},
+	"im": {CapKindString, EnterInsertMode},
+	"mk": {CapKindString, EnterSecureMode},
+	"mp": {CapKindString, EnterProtectedMode},
+	"mr": {CapKindString, EnterReverseMode},
+	"so": {CapKindString, EnterStandoutMode},
+	"us": {CapKindString, EnterUnderlineMode},
+	"ec": {CapKindString, EraseChars},
+	"ae": {CapKindString, ExitAltCharsetMode},
+	"me": {CapKindString, ExitAttributeMode},
+	"te": {CapKindString, ExitCaMode},
+	"ed": {CapKindString, ExitDeleteMode},
+	"ei": {CapKindString, ExitInsertMode},
+	"se": {CapKindString, ExitStandoutMode},
+	"ue": {CapKindString, ExitUnderlineMode},
+	"vb": {CapKindString, FlashScreen},
+	"ff": {CapKindString, FormFeed},
+	"fs": {CapKindString, FromStatusLine},
+	"i1": {CapKindString, Init1string},
+	"is": {CapKindString, Init2string},
+	"i3": {CapKindString, Init3string},
+	"if": {CapKindString, InitFile},
+	"ic": {CapKindString, InsertCharacter},
+	"al": {CapKindString, InsertLine},
+	"ip": {CapKindString, InsertPadding},
+	"kb": {CapKindString, KeyBackspace},
+	"ka": {CapKindString, KeyCatab},
+	"kC": {CapKindString, KeyClear},
+	"kt": {CapKindString, KeyCtab},
+	"kD": {CapKindString, KeyDc},
+	"kL": {CapKindString, KeyDl},
+	"kd": {CapKindString, KeyDown},
+	"kM": {CapKindString, KeyEic},
+	"kE": {CapKindString, KeyEol},
+	"kS": {CapKindString, KeyEos},
+	"k0": {CapKindString, KeyF0},
+	"k1": {CapKindString, KeyF1},
+	"k;": {CapKindString, KeyF10},
+	"k2": {CapKindString, KeyF2},
+	"k3": {CapKindString, KeyF3},
+	"k4": {CapKindString, KeyF4},
+	"k5": {CapKindString, KeyF5},
+	"k6": {CapKindString, KeyF6},
+	"k7": {CapKindString, KeyF7},
+	"k8": {CapKindString, KeyF8},
+	"k9": {CapKindString, KeyF9},
+	"kh": {CapKindString, KeyHome},
+	"kI": {CapKindString, KeyIc},
+	"kA": {CapKindString, KeyIl},
+	"kl": {CapKindString, KeyLeft},
+	"kH": {CapKindString, KeyLl},
+	"kN": {CapKindString, KeyNpage},
+	"kP": {CapKindString, KeyPpage},
+	"kr": {CapKindString, KeyRight},
+	"kF": {CapKindString, KeySf},
+	"kR": {CapKindString, KeySr},
+	"kT": {CapKindString, KeyStab},
+	"ku": {CapKindString, KeyUp},
+	"ke": {CapKindString, KeypadLocal},
+	"ks": {CapKindString, KeypadXmit},
+	"l0": {CapKindString, LabF0},
+	"l1": {CapKindString, LabF1},
+	"la": {CapKindString, LabF10},
+	"l2": {CapKindString, LabF2},
+	"l3": {CapKindString, LabF3},
+	"l4": {CapKindString, LabF4},
+	"l5": {CapKindString, LabF5},
+	"l6": {CapKindString, LabF6},
+	"l7": {CapKindString, LabF7},
+	"l8": {CapKindString, LabF8},
+	"l9": {CapKindString, LabF9},
+	"mo": {CapKindString, MetaOff},
+	"mm": {CapKindString, MetaOn},
+	"nw": {CapKindString, Newline},
+	"pc": {CapKindString, PadChar},
+	"DC": {CapKindString, ParmDch},
+	"DL": {CapKindString, ParmDeleteLine},
+	"DO": {CapKindString, ParmDownCursor},
+	"IC": {CapKindString, ParmIch},
+	"SF": {CapKindString, ParmIndex},
+	"AL": {CapKindString, ParmInsertLine},
+	"LE": {CapKindString, ParmLeftCursor},
+	"RI": {CapKindString, ParmRightCursor},
+	"SR": {CapKindString, ParmRindex},
+	"UP": {CapKindString, ParmUpCursor},
+	"pk": {CapKindString, PkeyKey},
+	"pl": {CapKindString, PkeyLocal},
+	"px": {CapKindString, PkeyXmit},
+	"ps": {CapKindString, PrintScreen},
+	"pf": {CapKindString, PrtrOff},
+	"po": {CapKindString, PrtrOn},
+	"rp": {CapKindString, RepeatChar},
+	"r1": {CapKindString, Reset1string},
+	"r2": {CapKindString, Reset2string},
+	"r3": {CapKindString, Reset3string},
+	"rf": {CapKindString, ResetFile},
+	"rc": {CapKindString, RestoreCursor},
+	"cv": {CapKindString, RowAddress},
+	"sc": {CapKindString, SaveCursor},
+	"sf": {CapKindString, ScrollForward},
+	"sr": {CapKindString, ScrollReverse},
+	"sa": {CapKindString, SetAttributes},
+	"st": {CapKindString, SetTab},
+	"wi": {CapKindString, SetWindow},
+	"ta": {CapKindString, Tab},
+	"ts": {CapKindString, ToStatusLine},
+	"uc": {CapKindString, UnderlineChar},
+	"hu": {CapKindString, UpHalfLine},
+	"iP": {CapKindString, InitProg},
+	"K1": {CapKindString, KeyA1},
+	"K3": {CapKindString, KeyA3},
+	"K2": {CapKindString, KeyB2},
+	"K4": {CapKindString, KeyC1},
+	"K5": {CapKindString, KeyC3},
+	"pO": {CapKindString, PrtrNon},
+	"rP": {CapKindString, CharPadding},
+	"ac": {CapKindString, AcsChars},
+	"pn": {CapKindString, PlabNorm},
+	"kB": {CapKindString, KeyBtab},
+	"SX": {CapKindString, EnterXonMode},
+	"RX": {CapKindString, ExitXonMode},
+	"SA": {CapKindString, EnterAmMode},
+	"RA": {CapKindString, ExitAmMode},
+	"XN": {CapKindString, XonCharacter},
+	"XF": {CapKindString, XoffCharacter},
+	"eA": {CapKindString, EnaAcs},
+	"LO": {CapKindString, LabelOn},
+	"LF": {CapKindString, LabelOff},
+	"@1": {CapKindString, KeyBeg},
+	"@2": {CapKindString, KeyCancel},
+	"@3": {CapKindString, KeyClose},
+	"@4": {CapKindString, KeyCommand},
+	"@5": {CapKindString, KeyCopy},
+	"@6": {CapKindString, KeyCreate},
+	"@7": {CapKindString, KeyEnd},
+	"@8": {CapKindString, KeyEnter},
+	"@9": {CapKindString, KeyExit},
+	"@0": {CapKindString, KeyFind},
+	"%1": {CapKindString, KeyHelp},
+	"%2": {CapKindString, KeyMark},
+	"%3": {CapKindString, KeyMessage},
+	"%4": {CapKindString, KeyMove},
+	"%5": {CapKindString, KeyNext},
+	"%6": {CapKindString, KeyOpen},
+	"%7": {CapKindString, KeyOptions},
+	"%8": {CapKindString, KeyPrevious},
+	"%9": {CapKindString, KeyPrint},
+	"%0": {CapKindString, KeyRedo},
+	"&1": {CapKindString, KeyReference},
+	"&2": {CapKindString, KeyRefresh},
+	"&3": {CapKindString, KeyReplace},
+	"&4": {CapKindString, KeyRestart},
+	"&5": {CapKindString, KeyResume},
+	"&6": {CapKindString, KeySave},
+	"&7": {CapKindString, KeySuspend},
+	"&8": {CapKindString, KeyUndo},
+	"&9": {CapKindString, KeySbeg},
+	"&0": {CapKindString, KeyScancel},
+	"*1": {CapKindString, KeyScommand},
+	"*2": {CapKindString, KeyScopy},
+	"*3": {CapKindString, KeyScreate},
+	"*4": {CapKindString, KeySdc},
+	"*5": {CapKindString, KeySdl},
+	"*6": {CapKindString, KeySelect},
+	"*7": {CapKindString, KeySend},
+	"*8": {CapKindString, KeySeol},
+	"*9": {CapKindString, KeySexit},
+	"*0": {CapKindString, KeySfind},
+	"#1": {CapKindString, KeyShelp},
+	"#2": {CapKindString, KeyShome},
+	"#3": {CapKindString, KeySic},
+	"#4": {CapKindString, KeySleft},
+	"%a": {CapKindString, KeySmessage},
+	"%b": {CapKindString, KeySmove},
+	"%c": {CapKindString, KeySnext},
+	"%d": {CapKindString, KeySoptions},
+	"%e": {CapKindString, KeySprevious},
+	"%f": {CapKindString, KeySprint},
+	"%g": {CapKindString, KeySredo},
+	"%h": {CapKindString, KeySreplace},
+	"%i": {CapKindString, KeySright},
+	"%j": {CapKindString, KeySrsume},
+	"!1": {CapKindString, KeySsave},
+	"!2": {CapKindString, KeySsuspend},
+	"!3": {CapKindString, KeySundo},
+	"RF": {CapKindString, ReqForInput},
+	"F1": {CapKindString, KeyF11},
+	"F2": {CapKindString, KeyF12},
+	"F3": {CapKindString, KeyF13},
+	"F4": {CapKindString, KeyF14},
+	"F5": {CapKindString, KeyF15},
+	"F6": {CapKindString, KeyF16},
+	"F7": {CapKindString, KeyF17},
+	"F8": {CapKindString, KeyF18},
+	"F9": {CapKindString, KeyF19},
+	"FA": {CapKindString, KeyF20},
+	"FB": {CapKindString, KeyF21},
+	"FC": {CapKindString, KeyF22},
+	"FD": {CapKindString, KeyF23},
+	"FE": {CapKindString, KeyF24},
+	"FF": {CapKindString, KeyF25},
+	"FG": {CapKindString, KeyF26},
+	"FH": {CapKindString, KeyF27},
+	"FI": {CapKindString, KeyF28},
+	"FJ": {CapKindString, KeyF29},
+	"FK": {CapKindString, KeyF30},
+	"FL": {CapKindString, KeyF31},
+	"FM": {CapKindString, KeyF32},
+	"FN": {CapKindString, KeyF33},
+	"FO": {CapKindString, KeyF34},
+	"FP": {CapKindString, KeyF35},
+	"FQ": {CapKindString, KeyF36},
+	"FR": {CapKindString, KeyF37},
+	"FS": {CapKindString, KeyF38},
+	"FT": {CapKindString, KeyF39},
+	"FU": {CapKindString, KeyF40},
+	"FV": {CapKindString, KeyF41},
+	"FW": {CapKindString, KeyF42},
+	"FX": {CapKindString, KeyF43},
+	"FY": {CapKindString, KeyF44},
+	"FZ": {CapKindString, KeyF45},
+	"Fa": {CapKindString, KeyF46},
+	"Fb": {CapKindString, KeyF47},
+	"Fc": {CapKindString, KeyF48},
+	"Fd": {CapKindString, KeyF49},
+	"Fe": {CapKindString, KeyF50},
+	"Ff": {CapKindString, KeyF51},
+	"Fg": {CapKindString, KeyF52},
+	"Fh": {CapKindString, KeyF53},
+	"Fi": {CapKindString, KeyF54},
+	"Fj": {CapKindString, KeyF55},
+	"Fk": {CapKindString, KeyF56},
+	"Fl": {CapKindString, KeyF57},
+	"Fm": {CapKindString, KeyF58},
+	"Fn": {CapKindString, KeyF59},
+	"Fo": {CapKindString, KeyF60},
+	"Fp": {CapKindString, KeyF61},
+	"Fq": {CapKindString, KeyF62},
+	"Fr": {CapKindString, KeyF63},
+	"cb": {CapKindString, ClrBol},
+	"MC": {CapKindString, ClearMargins},
+	"ML": {CapKindString, SetLeftMargin},
+	"MR": {CapKindString, SetRightMargin},
+	"Lf": {CapKindString, LabelFormat},
+	"SC": {CapKindString, SetClock},
+	"DK": {CapKindString, DisplayClock},
+	"RC": {CapKindString, RemoveClock},
+	"CW": {CapKindString, CreateWindow},
+	"WG": {CapKindString, GotoWindow},
+	"HU": {CapKindString, Hangup},
+	"DI": {CapKindString, DialPhone},
+	"QD": {CapKindString, QuickDial},
+	"TO": {CapKindString, Tone},
+	"PU": {CapKindString, Pulse},
+	"fh": {CapKindString, FlashHook},
+	"PA": {CapKindString, FixedPause},
+	"WA": {CapKindString, WaitTone},
+	"u0": {CapKindString, User0},
+	"u1": {CapKindString, User1},
+	"u2": {CapKindString, User2},
+	"u3": {CapKindString, User3},
+	"u4": {CapKindString, User4},
+	"u5": {CapKindString, User5},
+	"u6": {CapKindString, User6},
+	"u7": {CapKindString, User7},
+	"u8": {CapKindString, User8},
+	"u9": {CapKindString, User9},
+	"op": {CapKindString, OrigPair},
+	"oc": {CapKindString, OrigColors},
+	"Ic": {CapKindString, InitializeColor},
+	"Ip": {CapKindString, InitializePair},
+	"sp": {CapKindString, SetColorPair},
+	"Sf": {CapKindString, SetForeground},
+	"Sb": {CapKindString, SetBackground},
+	"ZA": {CapKindString, ChangeCharPitch},
+	"ZB": {CapKindString, ChangeLinePitch},
+	"ZC": {CapKindString, ChangeResHorz},
+	"ZD": {CapKindString, ChangeResVert},
+	"ZE": {CapKindString, DefineChar},
+	"ZF": {CapKindString, EnterDoublewideMode},
+	"ZG": {CapKindString, EnterDraftQuality},
+	"ZH": {CapKindString, EnterItalicsMode},
+	"ZI": {CapKindString, EnterLeftwardMode},
+	"ZJ": {CapKindString, EnterMicroMode},
+	"ZK": {CapKindString, EnterNearLetterQuality},
+	"ZL": {CapKindString, EnterNormalQuality},
+	"ZM": {CapKindString, EnterShadowMode},
+	"ZN": {CapKindString, EnterSubscriptMode},
+	"ZO": {CapKindString, EnterSuperscriptMode},
+	"ZP": {CapKindString, EnterUpwardMode},
+	"ZQ": {CapKindString, ExitDoublewideMode},
+	"ZR": {CapKindString, ExitItalicsMode},
+	"ZS": {CapKindString, ExitLeftwardMode},
+	"ZT": {CapKindString, ExitMicroMode},
+	"ZU": {CapKindString, ExitShadowMode},
+	"ZV": {CapKindString, ExitSubscriptMode},
+	"ZW": {CapKindString, ExitSuperscriptMode},
+	"ZX": {CapKindString, ExitUpwardMode},
+	"ZY": {CapKindString, MicroColumnAddress},
+	"ZZ": {CapKindString, MicroDown},
+	"Za": {CapKindString, MicroLeft},
+	"Zb": {CapKindString, MicroRight},
+	"Zc": {CapKindString, MicroRowAddress},
+	"Zd": {CapKindString, MicroUp},
+	"Ze": {CapKindString, OrderOfPins},
+	"Zf": {CapKindString, ParmDownMicro},
+	"Zg": {CapKindString, ParmLeftMicro},
+	"Zh": {CapKindString, ParmRightMicro},
+	"Zi": {CapKindString, ParmUpMicro},
+	"Zj": {CapKindString, SelectCharSet},
+	"Zk": {CapKindString, SetBottomMargin},
+	"Zl": {CapKindString, SetBottomMarginParm},
+	"Zm": {CapKindString, SetLeftMarginParm},
+	"Zn": {CapKindString, SetRightMarginParm},
+	"Zo": {CapKindString, SetTopMargin},
+	"Zp": {CapKindString, SetTopMarginParm},
+	"Zq": {CapKindString, StartBitImage},
+	"Zr": {CapKindString, StartCharSetDef},
+	"Zs": {CapKindString, StopBitImage},
+	"Zt": {CapKindString, StopCharSetDef},
+	"Zu": {CapKindString, SubscriptCharacters},
+	"Zv": {CapKindString, SuperscriptCharacters},
+	"Zw": {CapKindString, TheseCauseCr},
+	"Zx": {CapKindString, ZeroMotion},
+	"Zy": {CapKindString, CharSetNames},
+	"Km": {CapKindString, KeyMouse},
+	"Mi": {CapKindString, MouseInfo},
+	"RQ": {CapKindString, ReqMousePos},
+	"Gm": {CapKindString, GetMouse},
+	"AF": {CapKindString, SetAForeground},
+	"AB": {CapKindString, SetABackground},
+	"xl": {CapKindString, PkeyPlab},
+	"dv": {CapKindString, DeviceType},
+	"ci": {CapKindString, CodeSetInit},
+	"s0": {CapKindString, Set0DesSeq},
+	"s1": {CapKindString, Set1DesSeq},
+	"s2": {CapKindString, Set2DesSeq},
+	"s3": {CapKindString, Set3DesSeq},
+	"Xy": {CapKindString, BitImageRepeat},
+	"Zz": {CapKindString, BitImageNewline},
+	"Yv": {CapKindString, BitImageCarriageReturn},
+	"Yw": {CapKindString, ColorNames},
+	"Yx": {CapKindString, DefineBitImageRegion},
+	"Yy": {CapKindString, EndBitImageRegion},
+	"Yz": {CapKindString, SetColorBand},
+	"YZ": {CapKindString, SetPageLength},
+	"S1": {CapKindString, DisplayPcChar},
+	"S2": {CapKindString, EnterPcCharsetMode},
+	"S3": {CapKindString, ExitPcCharsetMode},
+	"S4": {CapKindString, EnterScancodeMode},
+	"S5": {CapKindString, ExitScancodeMode},
+	"S6": {CapKindString, PcTermOptions},
+	"S7": {CapKindString, ScancodeEscape},
+	"S8": {CapKindString, AltScancodeEsc},
+	"Xh": {CapKindString, EnterHorizontalHlMode},
+	"Xl": {CapKindString, EnterLeftHlMode},
+	"Xo": {CapKindString, EnterLowHlMode},
+	"Xr": {CapKindString, EnterRightHlMode},
+	"Xt": {CapKindString, EnterTopHlMode},
+	"Xv": {CapKindString, EnterVerticalHlMode},
+	"sA": {CapKindString, SetAAttributes},
+	"YI": {CapKindString, SetPglenInch},
+	"i2": {CapKindString, TermcapInit2},
+	"rs": {CapKindString, TermcapReset},
+	"nl": {CapKindString, LinefeedIfNotLf},
+	"bc": {CapKindString, BackspaceIfNotBs},
+	"ko": {CapKindString, OtherNonFunctionKeys},
+	"G2": {CapKindString, AcsUlcorner},
+	"G3": {CapKindString, AcsLlcorner},
+	"G1": {CapKindString, AcsUrcorner},
+	"G4": {CapKindString, AcsLrcorner},
+	"GR": {CapKindString, AcsLtee},
+	"GL": {CapKindString, AcsRtee},
+	"GU": {CapKindString, AcsBtee},
+	"GD": {CapKindString, AcsTtee},
+	"GH": {CapKindString, AcsHline},
+	"GV": {CapKindString, AcsVline},
+	"GC": {CapKindString, AcsPlus},
+	"ml": {CapKindString, MemoryLock},
+	"mu": {CapKindString, MemoryUnlock},
+	"bx": {CapKindString, BoxChars1},
+}
@@ -0,0 +1,110 @@
+package terminfo
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+)
+
+// ClipboardSelection is the default clipboard selection.
+const ClipboardSelection = "c"
+
+// HasClipboard determines if the terminal supports setting the clipboard
+// through the Ms extended string.
+func (ti *Terminfo) HasClipboard() bool {
+	return ti.ExtString("Ms") != nil
+}
+
+// clipboard writes the Ms extended string to w, with selection and the
+// already encoded data as parameters.
+func (ti *Terminfo) clipboard(w io.Writer, selection, data string) error {
+	ms := ti.ExtString("Ms")
+	if ms == nil {
+		return ErrNoClipboard
+	}
+	if selection == "" {
+		selection = ClipboardSelection
+	}
+	_, err := io.WriteString(w, ti.StaticVars().Printf(ms, selection, data))
+	return err
+}
+
+// SetClipboard writes the string that sets the clipboard selection to data
+// to writer w. The selection is one or more of the xterm selection characters
+// (c, p, q, s, 0-7), and defaults to ClipboardSelection when empty.
+func (ti *Terminfo) SetClipboard(w io.Writer, selection string, data []byte) error {
+	return ti.clipboard(w, selection, base64.StdEncoding.EncodeToString(data))
+}
+
+// RequestClipboard writes the string that requests the contents of the
+// clipboard selection to writer w. The terminal's reply can be read with
+// ReadClipboard.
+func (ti *Terminfo) RequestClipboard(w io.Writer, selection string) error {
+	return ti.clipboard(w, selection, "?")
+}
+
+// ReadClipboard reads a clipboard reply (OSC 52) from r, returning the
+// selection and the decoded data. The reply can be terminated by either BEL
+// or ST, and no bytes are read past the end of the reply.
+func ReadClipboard(r io.Reader) (string, []byte, error) {
+	br, ok := r.(io.ByteReader)
+	if !ok {
+		br = &byteReader{r: r}
+	}
+
+	// read until the end of the reply
+	var buf []byte
+	for {
+		c, err := br.ReadByte()
+		if err == io.EOF {
+			return "", nil, ErrInvalidClipboardReply
+		} else if err != nil {
+			return "", nil, err
+		}
+		if c == '\a' {
+			break
+		}
+		buf = append(buf, c)
+		if bytes.HasSuffix(buf, []byte("\x1b\\")) {
+			buf = buf[:len(buf)-2]
+			break
+		}
+	}
+
+	// check prefix
+	if !bytes.HasPrefix(buf, []byte("\x1b]52;")) {
+		return "", nil, ErrInvalidClipboardReply
+	}
+	buf = buf[5:]
+
+	// split selection and data
+	i := bytes.IndexByte(buf, ';')
+	if i == -1 {
+		return "", nil, ErrInvalidClipboardReply
+	}
+	data, err := base64.StdEncoding.DecodeString(string(buf[i+1:]))
+	if err != nil {
+		return "", nil, ErrInvalidClipboardReply
+	}
+
+	return string(buf[:i]), data, nil
+}
+
+// byteReader wraps a reader, reading a single byte at a time.
+type byteReader struct {
+	r   io.Reader
+	buf [1]byte
+}
+
+// ReadByte satisfies the io.ByteReader interface.
+func (br *byteReader) ReadByte() (byte, error) {
+	for {
+		n, err := br.r.Read(br.buf[:])
+		if n == 1 {
+			return br.buf[0], nil
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+}
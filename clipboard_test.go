@@ -0,0 +1,58 @@
+package terminfo
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestSetClipboard(t *testing.T) {
+	ti := &Terminfo{
+		ExtStrings:     map[int][]byte{0: []byte("\x1b]52;%p1%s;%p2%s\a")},
+		ExtStringNames: map[int][]byte{0: []byte("Ms")},
+	}
+	buf := new(bytes.Buffer)
+	if err := ti.SetClipboard(buf, "", []byte("hello")); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if err := ti.RequestClipboard(buf, "p"); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if exp := "\x1b]52;c;aGVsbG8=\a\x1b]52;p;?\a"; buf.String() != exp {
+		t.Errorf("expected %q, got: %q", exp, buf.String())
+	}
+	if err := (&Terminfo{}).SetClipboard(buf, "c", nil); err != ErrNoClipboard {
+		t.Errorf("expected ErrNoClipboard, got: %v", err)
+	}
+}
+
+func TestReadClipboard(t *testing.T) {
+	tests := []struct {
+		s         string
+		selection string
+		data      string
+		err       error
+	}{
+		{"\x1b]52;c;aGVsbG8=\arest", "c", "hello", nil},
+		{"\x1b]52;p;d29ybGQ=\x1b\\rest", "p", "world", nil},
+		{"\x1b]52;c;\arest", "c", "", nil},
+		{"\x1b]52;c;!!\a", "", "", ErrInvalidClipboardReply},
+		{"\x1b]12;c;aGVsbG8=\a", "", "", ErrInvalidClipboardReply},
+		{"\x1b]52;c;aGVsbG8=", "", "", ErrInvalidClipboardReply},
+	}
+	for i, test := range tests {
+		r := strings.NewReader(test.s)
+		selection, data, err := ReadClipboard(iotest.OneByteReader(r))
+		if err != test.err {
+			t.Errorf("test %d expected error %v, got: %v", i, test.err, err)
+			continue
+		}
+		if selection != test.selection || string(data) != test.data {
+			t.Errorf("test %d expected %q %q, got: %q %q", i, test.selection, test.data, selection, string(data))
+		}
+		if test.err == nil && r.Len() != 4 {
+			t.Errorf("test %d should not read past the end of the reply", i)
+		}
+	}
+}
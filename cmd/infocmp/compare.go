@@ -0,0 +1,205 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/xo/terminfo"
+)
+
+// compareMode is a comparison mode.
+type compareMode int
+
+// compareMode values.
+const (
+	modeDiff compareMode = iota
+	modeCommon
+	modeNeither
+)
+
+// capValue is the formatted value and state of a cap in a terminfo.
+type capValue struct {
+	v     string
+	state terminfo.CapState
+
+	// common is the formatted value for caps common to all terms, when it
+	// differs from v.
+	common string
+}
+
+// compare prints the caps of tis matching the comparison mode to w, in the
+// same format as the standard Unix infocmp -L. As with ncurses, the obsolete
+// termcap caps and the ncurses extensions after the last standard cap of each
+// kind (OTbs, OTug and OTi2) are only compared with -x.
+func compare(w io.Writer, terms []string, tis []*terminfo.Terminfo, mode compareMode) {
+	fmt.Fprintf(w, "comparing %s to %s.\n", terms[0], strings.Join(terms[1:], ", "))
+	for _, sect := range []struct {
+		name      string
+		kind, ext terminfo.CapKind
+		count     int
+		last      int
+		capName   func(int) string
+		sep       string
+	}{
+		{"booleans", terminfo.CapKindBool, terminfo.CapKindExtBool, terminfo.CapCountBool, terminfo.BackspacesWithBs, terminfo.BoolCapName, ":"},
+		{"numbers", terminfo.CapKindNum, terminfo.CapKindExtNum, terminfo.CapCountNum, terminfo.MagicCookieGlitchUl, terminfo.NumCapName, ", "},
+		{"strings", terminfo.CapKindString, terminfo.CapKindExtString, terminfo.CapCountString, terminfo.TermcapInit2, terminfo.StringCapName, ", "},
+	} {
+		fmt.Fprintf(w, "    comparing %s.\n", sect.name)
+
+		// standard caps, sorted by name
+		count := sect.last + 1
+		if *flagExtended {
+			count = sect.count
+		}
+		names := make([]string, count)
+		index := make(map[string]int, count)
+		for i := 0; i < count; i++ {
+			names[i] = sect.capName(i)
+			index[names[i]] = i
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			vals := make([]capValue, len(tis))
+			for j, ti := range tis {
+				vals[j] = value(ti, sect.kind, index[name], true)
+			}
+			printCompare(w, name, vals, mode, sect.sep)
+		}
+
+		// extended caps, sorted by name
+		if *flagExtended {
+			compareExt(w, tis, mode, sect.ext, sect.sep)
+		}
+
+		// as with ncurses, use is listed as a string cap in none of the
+		// terms, as the terms are compiled
+		if mode == modeNeither && sect.kind == terminfo.CapKindString {
+			fmt.Fprintf(w, "\t!use.\n")
+		}
+	}
+}
+
+// compareExt prints the extended caps of kind of tis matching the
+// comparison mode to w.
+func compareExt(w io.Writer, tis []*terminfo.Terminfo, mode compareMode, kind terminfo.CapKind, sep string) {
+	indexes := make([]map[string]int, len(tis))
+	for j, ti := range tis {
+		indexes[j] = extIndex(ti, kind)
+	}
+	for _, name := range extNames(indexes) {
+		vals := make([]capValue, len(tis))
+		for j, ti := range tis {
+			i, ok := indexes[j][name]
+			vals[j] = value(ti, kind, i, ok)
+		}
+		printCompare(w, name, vals, mode, sep)
+	}
+}
+
+// printCompare prints the cap name with vals to w when it matches the
+// comparison mode.
+func printCompare(w io.Writer, name string, vals []capValue, mode compareMode, sep string) {
+	// as with ncurses, only the first two terms are compared for differences
+	if mode == modeDiff {
+		vals = vals[:2]
+	}
+	same, defined, absent := true, true, true
+	for _, v := range vals {
+		same = same && v.v == vals[0].v
+		defined = defined && v.state != terminfo.CapAbsent
+		absent = absent && v.state == terminfo.CapAbsent
+	}
+	switch {
+	case mode == modeDiff && !same:
+		s := make([]string, len(vals))
+		for i, v := range vals {
+			s[i] = v.v
+		}
+		fmt.Fprintf(w, "\t%s: %s.\n", name, strings.Join(s, sep))
+	case mode == modeCommon && same && defined:
+		v := vals[0].v
+		if vals[0].common != "" {
+			v = vals[0].common
+		}
+		fmt.Fprintf(w, "\t%s= %s.\n", name, v)
+	case mode == modeNeither && absent:
+		fmt.Fprintf(w, "\t!%s.\n", name)
+	}
+}
+
+// value returns the formatted value and state of the cap i of kind. Absent
+// bools are false, as in compiled terminfo files, and, as with ncurses,
+// cancelled caps are formatted the same as absent caps, except for cancelled
+// strings common to all terms, which are empty.
+func value(ti *terminfo.Terminfo, kind terminfo.CapKind, i int, ok bool) capValue {
+	state := terminfo.CapAbsent
+	if ok {
+		state = ti.State(kind, i)
+	}
+	switch kind {
+	case terminfo.CapKindBool, terminfo.CapKindExtBool:
+		switch state {
+		case terminfo.CapAbsent:
+			return capValue{v: "F", state: terminfo.CapPresent}
+		case terminfo.CapCancelled:
+			return capValue{v: "F", state: state}
+		}
+		b := ti.Bools[i]
+		if kind == terminfo.CapKindExtBool {
+			b = ti.ExtBools[i]
+		}
+		if b {
+			return capValue{v: "T", state: state}
+		}
+		return capValue{v: "F", state: state}
+	case terminfo.CapKindNum, terminfo.CapKindExtNum:
+		if state != terminfo.CapPresent {
+			return capValue{v: "NULL", state: state}
+		}
+		n := ti.Nums[i]
+		if kind == terminfo.CapKindExtNum {
+			n = ti.ExtNums[i]
+		}
+		return capValue{v: fmt.Sprintf("%d", n), state: state}
+	}
+	switch state {
+	case terminfo.CapAbsent:
+		return capValue{v: "NULL", state: state}
+	case terminfo.CapCancelled:
+		return capValue{v: "NULL", state: state, common: "''"}
+	}
+	s := ti.Strings[i]
+	if kind == terminfo.CapKindExtString {
+		s = ti.ExtStrings[i]
+	}
+	return capValue{v: "'" + escapeCompare(s) + "'", state: state}
+}
+
+// extIndex returns the map of the extended cap names of kind to their index.
+func extIndex(ti *terminfo.Terminfo, kind terminfo.CapKind) map[string]int {
+	m := make(map[string]int)
+	for _, c := range ti.ExtCaps() {
+		if _, ok := m[c.Name]; !ok && c.Kind == kind {
+			m[c.Name] = c.Index
+		}
+	}
+	return m
+}
+
+// extNames returns the sorted, unique names in indexes.
+func extNames(indexes []map[string]int) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, m := range indexes {
+		for name := range m {
+			if !seen[name] {
+				names, seen[name] = append(names, name), true
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
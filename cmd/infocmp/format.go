@@ -0,0 +1,398 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/xo/terminfo"
+)
+
+// field is a formatted cap.
+type field struct {
+	// name is the printed cap name.
+	name string
+
+	// value is the formatted value, including the # or = prefix, or @ when
+	// cancelled.
+	value string
+
+	// ops are the disassembled operations of a parameterized string.
+	ops []terminfo.Op
+}
+
+// String satisfies the Stringer interface.
+func (f field) String() string {
+	return f.name + f.value
+}
+
+// dump prints the caps of the terminfo to w. When base is not nil, only the
+// caps that differ from base are printed, followed by a use= (or tc=) for each
+// of uses.
+func dump(w io.Writer, ti *terminfo.Terminfo, uses []string, base *terminfo.Terminfo) {
+	fmt.Fprintf(w, "#\tReconstructed via %s from file: %s\n", strings.TrimPrefix(os.Args[0], "./"), ti.File)
+
+	var sects [][]field
+	for _, kind := range []terminfo.CapKind{terminfo.CapKindBool, terminfo.CapKindNum, terminfo.CapKindString} {
+		sects = append(sects, fields(ti, base, kind))
+	}
+	if len(uses) != 0 {
+		name := "use"
+		if *flagTermcap {
+			name = "tc"
+		}
+		var f []field
+		for _, use := range uses {
+			f = append(f, field{name: name, value: "=" + use})
+		}
+		sects = append(sects, f)
+	}
+
+	names := strings.TrimSpace(strings.Join(ti.Names, "|"))
+	if *flagTermcap {
+		printTermcap(w, names, sects)
+		return
+	}
+	printTerminfo(w, names, sects)
+}
+
+// printTerminfo prints the names and sections to w in terminfo format.
+func printTerminfo(w io.Writer, names string, sects [][]field) {
+	fmt.Fprintf(w, "%s,\n", names)
+	for _, sect := range sects {
+		for _, line := range wrap(sect, 2, 1) {
+			if len(line) == 1 {
+				annotate(w, line[0])
+			}
+			fmt.Fprintf(w, "\t%s,\n", joinFields(line, ", "))
+			for _, f := range line {
+				disasm(w, f)
+			}
+		}
+	}
+}
+
+// disasm prints the disassembled operations of the parameterized string in f
+// to w.
+func disasm(w io.Writer, f field) {
+	for _, op := range f.ops {
+		code := escape([]byte(op.Code))
+		fmt.Fprintf(w, "\t#   %s%-10s %s\n", strings.Repeat("  ", op.Nest), code, op)
+	}
+}
+
+// printTermcap prints the names and sections to w in termcap format.
+func printTermcap(w io.Writer, names string, sects [][]field) {
+	var lines [][]field
+	for _, sect := range sects {
+		lines = append(lines, wrap(sect, 1, 3)...)
+	}
+	if len(lines) == 0 {
+		fmt.Fprintf(w, "%s:\n", names)
+		return
+	}
+	fmt.Fprintf(w, "%s:\\\n", names)
+	for i, line := range lines {
+		if len(line) == 1 {
+			annotate(w, line[0])
+		}
+		end := "\\"
+		if i == len(lines)-1 {
+			end = ""
+		}
+		fmt.Fprintf(w, "\t:%s:%s\n", joinFields(line, ":"), end)
+	}
+}
+
+// annotate prints the description of the cap in f to w.
+func annotate(w io.Writer, f field) {
+	if info, ok := terminfo.LookupCapInfo(strings.TrimPrefix(f.name, "..")); *flagAnnotate && ok {
+		fmt.Fprintf(w, "\t# %s\n", info.Description)
+	}
+}
+
+// wrap splits fields into lines no longer than the -w width, where sep is the
+// length of the separator between fields, and extra is the length of the
+// line's other characters (excluding the leading tab). When the width is 0,
+// each field is on its own line.
+func wrap(fields []field, sep, extra int) [][]field {
+	var lines [][]field
+	var line []field
+	n := 8 + extra
+	for _, f := range fields {
+		l := len(f.String())
+		if len(line) != 0 && (*flagWidth <= 0 || n+sep+l > *flagWidth) {
+			lines, line, n = append(lines, line), nil, 8+extra
+		}
+		if len(line) != 0 {
+			n += sep
+		}
+		line, n = append(line, f), n+l
+	}
+	if len(line) != 0 {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// joinFields joins the fields with sep.
+func joinFields(fields []field, sep string) string {
+	s := make([]string, len(fields))
+	for i, f := range fields {
+		s[i] = f.String()
+	}
+	return strings.Join(s, sep)
+}
+
+// fields returns the sorted, formatted caps of kind (and the extended caps of
+// the same kind when -x is specified) of ti. When base is not nil, only the
+// caps that differ from base are returned, and caps only in base are returned
+// as cancelled.
+func fields(ti, base *terminfo.Terminfo, kind terminfo.CapKind) []field {
+	var ext terminfo.CapKind
+	var count int
+	switch kind {
+	case terminfo.CapKindBool:
+		ext, count = terminfo.CapKindExtBool, terminfo.CapCountBool
+	case terminfo.CapKindNum:
+		ext, count = terminfo.CapKindExtNum, terminfo.CapCountNum
+	case terminfo.CapKindString:
+		ext, count = terminfo.CapKindExtString, terminfo.CapCountString
+	}
+
+	// standard caps
+	var std []field
+	for i := 0; i < count; i++ {
+		if *flagTermcap && !*flagAllCaps && !bsdCap(kind, i) {
+			continue
+		}
+		if f, ok := format(ti, base, kind, capName(kind, i), i, i, true, base != nil); ok {
+			std = append(std, f)
+		}
+	}
+	sortFields(std)
+	if !*flagExtended {
+		return std
+	}
+
+	// extended caps
+	var x []field
+	idx := extIndex(ti, ext)
+	var bidx map[string]int
+	if base != nil {
+		bidx = extIndex(base, ext)
+	}
+	for _, name := range extNames([]map[string]int{idx, bidx}) {
+		i, ok := idx[name]
+		j, bok := bidx[name]
+		if *flagTermcap && len(name) != 2 {
+			continue
+		}
+		if f, ok := format(ti, base, ext, name, i, j, ok, bok); ok {
+			x = append(x, f)
+		}
+	}
+	sortFields(x)
+
+	return append(std, x...)
+}
+
+// sortFields sorts fields by name.
+func sortFields(fields []field) {
+	sort.Slice(fields, func(i, j int) bool {
+		return strings.TrimPrefix(fields[i].name, "..") < strings.TrimPrefix(fields[j].name, "..")
+	})
+}
+
+// bsdCaps are the termcap names of the standard bool, num and string caps in
+// BSD termcap.
+var bsdCaps = [...]map[string]bool{
+	capSet("am bw da db eo es gn hc hs hz in km mi ms os ul xb xn xo xs xt"),
+	capSet("co it li lm ma pb sg ug vt ws"),
+	capSet(`AL CC CM DC DL DO IC K1 K2 K3 K4 K5 LE RI SF SR UP al bl bt cd ce
+		cl cm cr cs ct dc dl dm do ds ec ed ei ff fs hd ho hu i1 i2 ic if im ip
+		is k0 k1 k2 k3 k4 k5 k6 k7 k8 k9 kD kH kI kN kP kb kd ke kh kl kr ks ku
+		le ll mb md me mh mm mo mr nd nw pc rc rp sa sc se sf so sr st ta te ti
+		ts uc ue up us vb ve vi vs`),
+}
+
+// capSet returns the set of the space separated names in s.
+func capSet(s string) map[string]bool {
+	m := make(map[string]bool)
+	for _, name := range strings.Fields(s) {
+		m[name] = true
+	}
+	return m
+}
+
+// bsdCap returns true when the standard cap i of kind is printed with -C
+// without -r. As with ncurses, only the BSD termcap caps and the obsolete
+// termcap caps are printed, as the others are terminfo-only.
+func bsdCap(kind terminfo.CapKind, i int) bool {
+	var name, termcap string
+	switch kind {
+	case terminfo.CapKindBool:
+		name, termcap = terminfo.BoolCapName(i), terminfo.BoolCapNameTermcap(i)
+	case terminfo.CapKindNum:
+		name, termcap = terminfo.NumCapName(i), terminfo.NumCapNameTermcap(i)
+	default:
+		name, termcap = terminfo.StringCapName(i), terminfo.StringCapNameTermcap(i)
+	}
+	if info, ok := terminfo.LookupCapInfo(name); ok && info.Category == terminfo.CapCategoryObsolete {
+		return true
+	}
+	return bsdCaps[kind][termcap]
+}
+
+// capName returns the printed name of the standard cap i of kind, or an
+// empty string if the cap has no name in the output format.
+func capName(kind terminfo.CapKind, i int) string {
+	switch {
+	case kind == terminfo.CapKindBool && *flagTermcap:
+		return terminfo.BoolCapNameTermcap(i)
+	case kind == terminfo.CapKindBool && *flagShort:
+		return terminfo.BoolCapNameShort(i)
+	case kind == terminfo.CapKindBool:
+		return terminfo.BoolCapName(i)
+	case kind == terminfo.CapKindNum && *flagTermcap:
+		return terminfo.NumCapNameTermcap(i)
+	case kind == terminfo.CapKindNum && *flagShort:
+		return terminfo.NumCapNameShort(i)
+	case kind == terminfo.CapKindNum:
+		return terminfo.NumCapName(i)
+	case *flagTermcap:
+		return terminfo.StringCapNameTermcap(i)
+	case *flagShort:
+		return terminfo.StringCapNameShort(i)
+	}
+	return terminfo.StringCapName(i)
+}
+
+// format formats the cap i of kind in ti, returning false if the cap should
+// not be printed. When base is not nil, the cap is compared to the cap j of
+// base, and ok and bok indicate whether the cap is defined by ti and base.
+func format(ti, base *terminfo.Terminfo, kind terminfo.CapKind, name string, i, j int, ok, bok bool) (field, bool) {
+	if name == "" || name == "-" {
+		return field{}, false
+	}
+	state, bstate := terminfo.CapAbsent, terminfo.CapAbsent
+	if ok {
+		state = ti.State(kind, i)
+	}
+	if base != nil && bok {
+		bstate = base.State(kind, j)
+	}
+
+	// compare with base
+	if base != nil && state == bstate && (state != terminfo.CapPresent || value(ti, kind, i, true) == value(base, kind, j, true)) {
+		return field{}, false
+	}
+
+	switch {
+	case state == terminfo.CapCancelled,
+		state == terminfo.CapAbsent && bstate == terminfo.CapPresent:
+		return field{name: name, value: "@"}, true
+	case state == terminfo.CapAbsent:
+		return field{}, false
+	}
+
+	switch kind {
+	case terminfo.CapKindBool:
+		return field{name: name}, ti.Bools[i]
+	case terminfo.CapKindExtBool:
+		return field{name: name}, ti.ExtBools[i]
+	case terminfo.CapKindNum:
+		return field{name: name, value: "#" + strconv.Itoa(ti.Nums[i])}, true
+	case terminfo.CapKindExtNum:
+		return field{name: name, value: "#" + strconv.Itoa(ti.ExtNums[i])}, true
+	}
+
+	s := ti.Strings[i]
+	if kind == terminfo.CapKindExtString {
+		s = ti.ExtStrings[i]
+	}
+	if kind == terminfo.CapKindString && i == terminfo.AcsChars && len(bytes.TrimSpace(s)) == 0 {
+		return field{}, false
+	}
+	if *flagTermcap {
+		if z, ok := infoToCap(s); ok {
+			return field{name: name, value: "=" + escapeTermcap(z)}, true
+		}
+		return field{name: ".." + name, value: "=" + escape(s)}, true
+	}
+	f := field{name: name, value: "=" + escape(s)}
+	if *flagDisasm && bytes.IndexByte(s, '%') != -1 {
+		f.ops = terminfo.Disassemble(s)
+	}
+	return f, true
+}
+
+// infoToCap converts the terminfo string s to termcap, returning false when s
+// uses terminfo features that have no termcap equivalent.
+//
+// Trailing padding is moved to the start of the string, and parameters are
+// only converted when pushed in order (or not pushed at all) and immediately
+// output.
+func infoToCap(s []byte) ([]byte, bool) {
+	// move trailing padding
+	var pad []byte
+	if n := len(s); n > 3 && s[n-1] == '>' {
+		if i := bytes.LastIndex(s, []byte("$<")); i != -1 {
+			p := bytes.TrimSuffix(s[i+2:n-1], []byte("/"))
+			if len(bytes.Trim(p, "0123456789.*")) != 0 {
+				return nil, false
+			}
+			pad, s = p, s[:i]
+		}
+	}
+	if bytes.Contains(s, []byte("$<")) {
+		return nil, false
+	}
+
+	buf := append([]byte{}, pad...)
+	next := 1
+	for i := 0; i < len(s); i++ {
+		if s[i] != '%' {
+			buf = append(buf, s[i])
+			continue
+		}
+		rest := string(s[i+1:])
+		switch {
+		case strings.HasPrefix(rest, "%"), strings.HasPrefix(rest, "i"):
+			buf = append(buf, '%', rest[0])
+			i++
+			continue
+		case len(rest) > 1 && rest[0] == 'p' && int(rest[1]-'0') == next:
+			next, i = next+1, i+2
+			rest = string(s[i+1:])
+		default:
+			// implicit parameter, as used by entries converted from termcap
+			rest = "%" + rest
+			i--
+		}
+
+		// output of the parameter
+		var out string
+		var n int
+		switch {
+		case strings.HasPrefix(rest, "%d"):
+			out, n = "%d", 2
+		case strings.HasPrefix(rest, "%2d"), strings.HasPrefix(rest, "%02d"):
+			out, n = "%2", strings.Index(rest, "d")+1
+		case strings.HasPrefix(rest, "%3d"), strings.HasPrefix(rest, "%03d"):
+			out, n = "%3", strings.Index(rest, "d")+1
+		case strings.HasPrefix(rest, "%c"):
+			out, n = "%.", 2
+		case len(rest) > 7 && strings.HasPrefix(rest, "%'") && rest[3:8] == "'%+%c":
+			out, n = "%+"+rest[2:3], 8
+		default:
+			return nil, false
+		}
+		buf, i = append(buf, out...), i+n
+	}
+	return buf, true
+}
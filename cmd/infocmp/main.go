@@ -1,5 +1,7 @@
 // Application infocmp should have the same output as the standard Unix infocmp
-// -1 -L output.
+// -1 -L output, and supports the -I, -C (and -r), -w and -u output formats and
+// the -d, -c and -n comparison modes. The -disasm flag prints the operations of
+// each parameterized string.
 package main
 
 import (
@@ -7,8 +9,6 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"sort"
-	"strings"
 	"unicode"
 
 	"github.com/xo/terminfo"
@@ -17,94 +17,76 @@ import (
 var (
 	flagTerm     = flag.String("term", os.Getenv("TERM"), "term name")
 	flagExtended = flag.Bool("x", false, "extended options")
+	flagAnnotate = flag.Bool("annotate", false, "annotate caps with their description")
+	flagDiff     = flag.Bool("d", false, "list caps that differ between terms")
+	flagCommon   = flag.Bool("c", false, "list caps common to all terms")
+	flagNeither  = flag.Bool("n", false, "list caps in none of the terms")
+	flagDirA     = flag.String("A", "", "terminfo directory for the first term")
+	flagDirB     = flag.String("B", "", "terminfo directory for the other terms")
+	flagShort    = flag.Bool("I", false, "use short (terminfo) cap names")
+	flagTermcap  = flag.Bool("C", false, "use termcap names and format")
+	flagAllCaps  = flag.Bool("r", false, "with -C, print all caps, not only the BSD termcap caps")
+	flagWidth    = flag.Int("w", 0, "wrap lines to width (0 prints one cap per line)")
+	flagUse      = flag.Bool("u", false, "print the first term relative to the other terms, with use=")
+	flagDisasm   = flag.Bool("disasm", false, "print the disassembled parameterized strings (terminfo format only)")
 )
 
 func main() {
 	flag.Parse()
 
-	ti, err := terminfo.Load(*flagTerm)
-	if err != nil {
-		log.Fatal(err)
+	// determine terms
+	terms := flag.Args()
+	if len(terms) == 0 {
+		terms = []string{*flagTerm}
 	}
 
-	fmt.Printf("#\tReconstructed via %s from file: %s\n", strings.TrimPrefix(os.Args[0], "./"), ti.File)
-	fmt.Printf("%s,\n", strings.TrimSpace(strings.Join(ti.Names, "|")))
-
-	process(ti.BoolCaps, ti.ExtBoolCaps, ti.BoolsM, terminfo.BoolCapName, nil)
-	process(
-		ti.NumCaps, ti.ExtNumCaps, ti.NumsM, terminfo.NumCapName,
-		func(v interface{}) string { return fmt.Sprintf("#%d", v) },
-	)
-	process(
-		ti.StringCaps, ti.ExtStringCaps, ti.StringsM, terminfo.StringCapName,
-		func(v interface{}) string { return "=" + escape(v.([]byte)) },
-	)
-}
-
-func process(x, y interface{}, m map[int]bool, name func(int) string, mask func(interface{}) string) {
-	printIt(x, m, name, mask)
-	if *flagExtended {
-		printIt(y, nil, name, mask)
-	}
-}
-
-// process walks the values in z, adding missing elements in m. a mask func can
-// be provided to format the values in z.
-func printIt(z interface{}, m map[int]bool, name func(int) string, mask func(interface{}) string) {
-	var names []string
-	x := make(map[string]string)
-	switch v := z.(type) {
-	case func() map[string]bool:
-		for n, a := range v() {
-			if !a {
-				continue
-			}
-			var f string
-			if mask != nil {
-				f = mask(a)
-			}
-			x[n], names = f, append(names, n)
+	// load
+	tis := make([]*terminfo.Terminfo, len(terms))
+	for i, term := range terms {
+		dir := *flagDirB
+		if i == 0 {
+			dir = *flagDirA
 		}
-
-	case func() map[string]int:
-		for n, a := range v() {
-			if a < 0 {
-				continue
-			}
-			var f string
-			if mask != nil {
-				f = mask(a)
-			}
-			x[n], names = f, append(names, n)
+		var err error
+		if tis[i], err = load(dir, term); err != nil {
+			log.Fatal(err)
 		}
+	}
 
-	case func() map[string][]byte:
-		for n, a := range v() {
-			if a == nil {
-				continue
-			}
-			var f string
-			if mask != nil {
-				f = mask(a)
-			}
-			if n == "acs_chars" && strings.TrimSpace(strings.TrimPrefix(f, "=")) == "" {
-				continue
-			}
-			x[n], names = f, append(names, n)
+	var mode compareMode
+	switch {
+	case *flagUse:
+		if len(tis) < 2 {
+			log.Fatal("-u requires two or more terms")
 		}
+		dump(os.Stdout, tis[0], terms[1:], terminfo.Merge(tis[1], tis[2:]...))
+		return
+	case *flagDiff:
+		mode = modeDiff
+	case *flagCommon:
+		mode = modeCommon
+	case *flagNeither:
+		mode = modeNeither
+	default:
+		for _, ti := range tis {
+			dump(os.Stdout, ti, nil, nil)
+		}
+		return
 	}
 
-	// add missing
-	for i := range m {
-		n := name(i)
-		x[n], names = "@", append(names, n)
+	if len(tis) < 2 {
+		log.Fatal("comparing requires two or more terms")
 	}
+	compare(os.Stdout, terms, tis, mode)
+}
 
-	// sort and print
-	sort.Strings(names)
-	for _, n := range names {
-		fmt.Printf("\t%s%s,\n", n, x[n])
+// load loads the term from the terminfo directory dir, or from the default
+// locations when dir is empty.
+func load(dir, term string) (*terminfo.Terminfo, error) {
+	if dir != "" {
+		return terminfo.Open(dir, term)
 	}
+	return terminfo.Load(term)
 }
 
 // peek peeks a byte.
@@ -135,8 +117,26 @@ func isdigit(b byte) bool {
 	return unicode.IsDigit(rune(b))
 }
 
-// logic taken from _nc_tic_expand from ncurses-6.0/ncurses/tinfo/comp_expand.c
+// escape escapes buf for the terminfo format.
 func escape(buf []byte) string {
+	return expand(buf, false, false)
+}
+
+// escapeTermcap escapes buf for the termcap format, which, as with ncurses,
+// always prints carriage returns and newlines as \r and \n.
+func escapeTermcap(buf []byte) string {
+	return expand(buf, true, false)
+}
+
+// escapeCompare escapes buf for the comparison modes, which, as with ncurses,
+// always print carriage returns and newlines as \r and \n, and other control
+// characters as ^X.
+func escapeCompare(buf []byte) string {
+	return expand(buf, true, true)
+}
+
+// logic taken from _nc_tic_expand from ncurses-6.0/ncurses/tinfo/comp_expand.c
+func expand(buf []byte, crlf, ctl bool) string {
 	length := len(buf)
 	if length == 0 {
 		return ""
@@ -160,13 +160,13 @@ func escape(buf []byte) string {
 		case realprint(ch) && ch != ',' && ch != ':' && ch != '!' && ch != '^':
 			s = append(s, ch)
 
-		case ch == '\r' && (islong || (i == length-1 && length > 2)):
+		case ch == '\r' && (crlf || islong || (i == length-1 && length > 2)):
 			s = append(s, '\\', 'r')
 
-		case ch == '\n' && islong:
+		case ch == '\n' && (crlf || islong):
 			s = append(s, '\\', 'n')
 
-		case realctl(ch) && ch != '\\' && (!islong || isdigit(peek(buf, i+1, length))):
+		case realctl(ch) && ch != '\\' && (ctl || !islong || isdigit(peek(buf, i+1, length))):
 			s = append(s, '^', ch+'@')
 
 		default:
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/xo/terminfo"
+)
+
+var flagUpdate = flag.Bool("infocmp", false, "regenerate the expected output in testdata with ncurses' infocmp")
+
+func TestCompare(t *testing.T) {
+	defer func(x bool) { *flagExtended = x }(*flagExtended)
+
+	tests := []struct {
+		mode     compareMode
+		extended bool
+		terms    []string
+	}{
+		{modeDiff, false, []string{"xterm", "xterm-256color"}},
+		{modeCommon, false, []string{"xterm", "xterm-256color"}},
+		{modeNeither, false, []string{"xterm", "xterm-256color"}},
+		{modeDiff, true, []string{"xterm", "xterm-256color"}},
+		{modeCommon, true, []string{"xterm", "xterm-256color"}},
+		{modeNeither, true, []string{"xterm", "xterm-256color"}},
+		// cancelled caps
+		{modeDiff, false, []string{"rxvt", "putty"}},
+		// carriage returns and newlines
+		{modeDiff, false, []string{"linux", "screen"}},
+		// only the first two terms are compared for differences
+		{modeDiff, false, []string{"adm3a", "vt52", "cons25"}},
+		{modeCommon, false, []string{"adm3a", "vt52", "cons25"}},
+		{modeNeither, false, []string{"adm3a", "vt52", "cons25"}},
+	}
+	for i, test := range tests {
+		opt := "-" + string("dcn"[test.mode])
+		if test.extended {
+			opt = "-x" + opt[1:]
+		}
+		file := filepath.Join("testdata", opt[1:]+"-"+strings.Join(test.terms, "-")+".txt")
+		if *flagUpdate {
+			args := append([]string{"-L", opt, "-A", "../../testdata/terminfo", "-B", "../../testdata/terminfo"}, test.terms...)
+			out, err := exec.Command("infocmp", args...).Output()
+			if err != nil {
+				t.Fatalf("test %d expected no error, got: %v", i, err)
+			}
+			if err := ioutil.WriteFile(file, out, 0644); err != nil {
+				t.Fatalf("test %d expected no error, got: %v", i, err)
+			}
+		}
+		exp, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatalf("test %d expected no error, got: %v", i, err)
+		}
+
+		tis := make([]*terminfo.Terminfo, len(test.terms))
+		for j, term := range test.terms {
+			if tis[j], err = terminfo.Open("../../testdata/terminfo", term); err != nil {
+				t.Fatalf("test %d expected no error, got: %v", i, err)
+			}
+		}
+		*flagExtended = test.extended
+		buf := new(bytes.Buffer)
+		compare(buf, test.terms, tis, test.mode)
+		if s := buf.String(); s != string(exp) {
+			t.Errorf("test %d %s %s expected:\n%s\ngot:\n%s", i, opt, strings.Join(test.terms, " "), exp, s)
+		}
+	}
+}
+
+func TestEscapeCompare(t *testing.T) {
+	tests := []struct {
+		s, exp string
+	}{
+		{"\r", `\r`},
+		{"ab\r", `ab\r`},
+		{"\r5xyz", `\r5xyz`},
+		{"\n", `\n`},
+		{"abcd\n", `abcd\n`},
+		{"\x07", `^G`},
+		{"abcd\x07", `abcd^G`},
+		{"\x015xyz", `^A5xyz`},
+		{"\x1b[H", `\E[H`},
+		{"ab,", `ab\054`},
+		{"ab\x7f", `ab\177`},
+		{"\x80", `\0`},
+	}
+	for i, test := range tests {
+		if s := escapeCompare([]byte(test.s)); s != test.exp {
+			t.Errorf("test %d expected %q, got: %q", i, test.exp, s)
+		}
+	}
+}
+
+func TestDump(t *testing.T) {
+	defer func(c, r bool, w int) { *flagTermcap, *flagAllCaps, *flagWidth = c, r, w }(*flagTermcap, *flagAllCaps, *flagWidth)
+
+	tests := []struct {
+		terms   []string
+		termcap bool
+		allCaps bool
+		width   int
+		exp     string
+	}{
+		{[]string{"dumb"}, false, false, 0, "dumb|80-column dumb tty,\n\tauto_right_margin,\n\tcolumns#80,\n\tbell=^G,\n\tcarriage_return=^M,\n\tcursor_down=^J,\n\tscroll_forward=^J,\n"},
+		{[]string{"dumb"}, false, false, 40, "dumb|80-column dumb tty,\n\tauto_right_margin,\n\tcolumns#80,\n\tbell=^G, carriage_return=^M,\n\tcursor_down=^J,\n\tscroll_forward=^J,\n"},
+		{[]string{"dumb"}, true, false, 0, "dumb|80-column dumb tty:\\\n\t:am:\\\n\t:co#80:\\\n\t:bl=^G:\\\n\t:cr=\\r:\\\n\t:do=\\n:\\\n\t:sf=\\n:\n"},
+		{[]string{"dumb"}, true, false, 40, "dumb|80-column dumb tty:\\\n\t:am:\\\n\t:co#80:\\\n\t:bl=^G:cr=\\r:do=\\n:sf=\\n:\n"},
+		// only the caps differing from the other terms, and use=
+		{[]string{"xterm-256color", "xterm"}, false, false, 40, "xterm-256color|xterm with 256 colors,\n\tcan_change,\n\tmax_colors#256, max_pairs#65536,\n" +
+			"\tinitialize_color=\\E]4;%p1%d;rgb\\072%p2%{255}%*%{1000}%/%2.2X/%p3%{255}%*%{1000}%/%2.2X/%p4%{255}%*%{1000}%/%2.2X\\E\\,\n" +
+			"\torig_colors=\\E]104\\007,\n\treset_1string=\\Ec\\E]104\\007,\n" +
+			"\tset_a_background=\\E[%?%p1%{8}%<%t4%p1%d%e%p1%{16}%<%t10%p1%{8}%-%d%e48;5;%p1%d%;m,\n" +
+			"\tset_a_foreground=\\E[%?%p1%{8}%<%t3%p1%d%e%p1%{16}%<%t9%p1%{8}%-%d%e38;5;%p1%d%;m,\n" +
+			"\tset_background@,\n\tset_foreground@,\n\tuse=xterm,\n"},
+		// as with ncurses, only the BSD termcap caps, unless -r
+		{[]string{"xterm-256color", "xterm"}, true, false, 0, "xterm-256color|xterm with 256 colors:\\\n\t:tc=xterm:\n"},
+		{[]string{"xterm-256color", "xterm"}, true, true, 0, "xterm-256color|xterm with 256 colors:\\\n\t:cc:\\\n\t:Co#256:\\\n\t:pa#65536:\\\n" +
+			"\t:..AB=\\E[%?%p1%{8}%<%t4%p1%d%e%p1%{16}%<%t10%p1%{8}%-%d%e48;5;%p1%d%;m:\\\n" +
+			"\t:..AF=\\E[%?%p1%{8}%<%t3%p1%d%e%p1%{16}%<%t9%p1%{8}%-%d%e38;5;%p1%d%;m:\\\n" +
+			"\t:..Ic=\\E]4;%p1%d;rgb\\072%p2%{255}%*%{1000}%/%2.2X/%p3%{255}%*%{1000}%/%2.2X/%p4%{255}%*%{1000}%/%2.2X\\E\\:\\\n" +
+			"\t:Sb@:\\\n\t:Sf@:\\\n\t:oc=\\E]104\\007:\\\n\t:r1=\\Ec\\E]104\\007:\\\n\t:tc=xterm:\n"},
+	}
+	for i, test := range tests {
+		tis := make([]*terminfo.Terminfo, len(test.terms))
+		for j, term := range test.terms {
+			var err error
+			if tis[j], err = terminfo.Open("../../testdata/terminfo", term); err != nil {
+				t.Fatalf("test %d expected no error, got: %v", i, err)
+			}
+		}
+		var base *terminfo.Terminfo
+		if len(tis) > 1 {
+			base = terminfo.Merge(tis[1], tis[2:]...)
+		}
+		*flagTermcap, *flagAllCaps, *flagWidth = test.termcap, test.allCaps, test.width
+		buf := new(bytes.Buffer)
+		dump(buf, tis[0], test.terms[1:], base)
+		// skip the reconstructed header
+		s := buf.String()
+		s = s[strings.IndexByte(s, '\n')+1:]
+		if s != test.exp {
+			t.Errorf("test %d expected:\n%s\ngot:\n%s", i, test.exp, s)
+		}
+	}
+}
+
+func TestWrap(t *testing.T) {
+	defer func(w int) { *flagWidth = w }(*flagWidth)
+
+	tests := []struct {
+		width      int
+		names      string
+		sep, extra int
+		exp        string
+	}{
+		{0, "ab cd ef", 2, 1, "ab|cd|ef"},
+		{-1, "ab cd ef", 2, 1, "ab|cd|ef"},
+		{18, "abcd abcd abcd", 2, 1, "abcd|abcd|abcd"},
+		{19, "abcd abcd abcd", 2, 1, "abcd abcd|abcd"},
+		{24, "abcd abcd abcd", 2, 1, "abcd abcd|abcd"},
+		{25, "abcd abcd abcd", 2, 1, "abcd abcd abcd"},
+		{24, "abcd abcd abcd", 1, 3, "abcd abcd|abcd"},
+		{25, "abcd abcd abcd", 1, 3, "abcd abcd abcd"},
+		// fields longer than the width are on their own line
+		{20, "ab abcdefghijklmnopqrstuvwxyz ab", 2, 1, "ab|abcdefghijklmnopqrstuvwxyz|ab"},
+		{20, "", 2, 1, ""},
+	}
+	for i, test := range tests {
+		*flagWidth = test.width
+		var fields []field
+		for _, name := range strings.Fields(test.names) {
+			fields = append(fields, field{name: name})
+		}
+		var lines []string
+		for _, line := range wrap(fields, test.sep, test.extra) {
+			lines = append(lines, joinFields(line, " "))
+		}
+		if s := strings.Join(lines, "|"); s != test.exp {
+			t.Errorf("test %d expected %q, got: %q", i, test.exp, s)
+		}
+	}
+}
+
+func TestInfoToCap(t *testing.T) {
+	tests := []struct {
+		s, exp string
+		ok     bool
+	}{
+		{"\x1b[H", "\x1b[H", true},
+		{"\x1b[%i%p1%d;%p2%dH", "\x1b[%i%d;%dH", true},
+		{"\x1bY%p1%' '%+%c%p2%' '%+%c", "\x1bY%+ %+ ", true},
+		{"\x1b[%p1%2d", "\x1b[%2", true},
+		{"\x1b[%p1%03d", "\x1b[%3", true},
+		{"%p1%c", "%.", true},
+		{"100%%", "100%%", true},
+		// implicit params, as converted from termcap
+		{"\x1b[%dB", "\x1b[%dB", true},
+		// trailing padding is moved to the start
+		{"\x1b[J$<50>", "50\x1b[J", true},
+		{"\x1b[K$<3/>", "3\x1b[K", true},
+		{"\x1b[K$<3*>", "3*\x1b[K", true},
+		// untranslatable
+		{"$<5>\x1b[H", "", false},
+		{"\x1b[K$<x>", "", false},
+		{"\x1b[%p2%d;%p1%dH", "", false},
+		{"\x1b[%p1%x", "", false},
+		{"\x1b[%?%p1%t;1%;m", "", false},
+		{"\x1b[%p1%{1}%+%dm", "", false},
+	}
+	for i, test := range tests {
+		z, ok := infoToCap([]byte(test.s))
+		if ok != test.ok {
+			t.Errorf("test %d %q expected ok %t, got: %t", i, test.s, test.ok, ok)
+		}
+		if ok && string(z) != test.exp {
+			t.Errorf("test %d %q expected %q, got: %q", i, test.s, test.exp, string(z))
+		}
+	}
+}
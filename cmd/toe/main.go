@@ -0,0 +1,123 @@
+// Application toe lists the entries in the terminfo databases, similar to the
+// standard Unix toe.
+//
+// By default, every entry reachable through the directories searched by
+// terminfo.Load is listed with its description. With -a, the entries of each
+// directory are listed separately. With -s, the output is the same as
+// ncurses' toe -a -s: each entry is prefixed with a column for each directory
+// (pointed to by the > of the directory's header line), marked with * for the
+// directory Load reads the entry from, and + for the other directories having
+// the entry. Unlike ncurses' toe, -s does not require -a.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/xo/terminfo"
+)
+
+var (
+	flagAll    = flag.Bool("a", false, "list the entries of each directory")
+	flagSource = flag.Bool("s", false, "show the directories containing each entry")
+)
+
+func main() {
+	flag.Parse()
+
+	all, err := terminfo.ListAll()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+
+	switch {
+	case *flagSource:
+		source(w, all)
+	case *flagAll:
+		var dir string
+		for _, e := range all {
+			if e.Dir != dir {
+				dir = e.Dir
+				fmt.Fprintf(w, "#--> %s\n", dir)
+			}
+			printEntry(w, "", e)
+		}
+	default:
+		err := terminfo.Walk(func(name string, ti *terminfo.Terminfo) error {
+			printNames(w, "", name, ti)
+			return nil
+		})
+		if err != nil {
+			w.Flush()
+			log.Fatal(err)
+		}
+	}
+}
+
+// source writes a header line for each directory, followed by the entries
+// prefixed with the directories containing them, to w.
+func source(w io.Writer, all []terminfo.Entry) {
+	var dirs []string
+	index := make(map[string]int)
+	byName := make(map[string][]terminfo.Entry)
+	var names []string
+	for _, e := range all {
+		if _, ok := index[e.Dir]; !ok {
+			index[e.Dir], dirs = len(dirs), append(dirs, e.Dir)
+		}
+		if _, ok := byName[e.Name]; !ok {
+			names = append(names, e.Name)
+		}
+		byName[e.Name] = append(byName[e.Name], e)
+	}
+	sort.Strings(names)
+
+	for i, dir := range dirs {
+		fmt.Fprintf(w, "%s> %s\n", strings.Repeat("--", i+1), dir)
+	}
+	for _, name := range names {
+		prefix := []byte(strings.Repeat("--", len(dirs)))
+		for i, e := range byName[name] {
+			c := byte('+')
+			if i == 0 {
+				c = '*'
+			}
+			prefix[2*index[e.Dir]] = c
+		}
+		printEntry(w, string(prefix)+":\t", byName[name][0])
+	}
+}
+
+// printEntry writes the primary name and description of the entry, prefixed
+// with prefix, to w. Aliases and entries that cannot be decoded are skipped.
+func printEntry(w io.Writer, prefix string, e terminfo.Entry) {
+	buf, err := ioutil.ReadFile(e.File)
+	if err != nil {
+		return
+	}
+	ti, err := terminfo.Decode(buf)
+	if err != nil || len(ti.Names) == 0 || ti.Names[0] != e.Name {
+		return
+	}
+	printNames(w, prefix, e.Name, ti)
+}
+
+// printNames writes the name and description of the terminfo, prefixed with
+// prefix, to w.
+func printNames(w io.Writer, prefix, name string, ti *terminfo.Terminfo) {
+	var desc string
+	if len(ti.Names) > 1 {
+		desc = ti.Names[len(ti.Names)-1]
+	}
+	fmt.Fprintf(w, "%s%-10s\t%s\n", prefix, name, desc)
+}
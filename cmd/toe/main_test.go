@@ -0,0 +1,35 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/xo/terminfo"
+)
+
+func TestSource(t *testing.T) {
+	entry := func(dir, name string) terminfo.Entry {
+		return terminfo.Entry{Name: name, Dir: dir, File: "../../testdata/terminfo/" + name[:1] + "/" + name}
+	}
+	all := []terminfo.Entry{
+		entry("/a", "xterm"),
+		entry("/a", "xterm-256color"),
+		entry("/b", "xterm"),
+		entry("/c", "vt100"),
+		entry("/d", "vt100"),
+		entry("/d", "xterm"),
+	}
+	// as with ncurses' toe -a -s
+	exp := "--> /a\n" +
+		"----> /b\n" +
+		"------> /c\n" +
+		"--------> /d\n" +
+		"----*-+-:\tvt100     \tDEC VT100 (w/advanced video)\n" +
+		"*-+---+-:\txterm     \txterm terminal emulator (X Window System)\n" +
+		"*-------:\txterm-256color\txterm with 256 colors\n"
+	buf := new(bytes.Buffer)
+	source(buf, all)
+	if s := buf.String(); s != exp {
+		t.Errorf("expected:\n%s\ngot:\n%s", exp, s)
+	}
+}
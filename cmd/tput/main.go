@@ -0,0 +1,217 @@
+// Application tput is a pure Go implementation of the standard Unix tput,
+// printing the value of a terminal capability.
+//
+// Exit codes are the same as ncurses' tput: 0 on success (or a true bool cap),
+// 1 for a false bool cap or undefined string cap, 2 for usage errors, 3 for
+// an unknown terminal, 4 for an unknown cap name, and 10 for init or reset
+// without a terminal.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/xo/terminfo"
+)
+
+// exit codes.
+const (
+	exitOK = iota
+	exitFalse
+	exitUsage
+	exitBadTerm
+	exitBadCap
+
+	// exitNoTerminal is the exit code when init or reset are used without a
+	// terminal.
+	exitNoTerminal = 10
+)
+
+var (
+	flagTerm  = flag.String("T", os.Getenv("TERM"), "term name")
+	flagStdin = flag.Bool("S", false, "read cap names and params from stdin")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: %s [-T term] [-S] capname [params...]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if (*flagStdin && flag.NArg() != 0) || (!*flagStdin && flag.NArg() == 0) {
+		flag.Usage()
+		os.Exit(exitUsage)
+	}
+
+	// load
+	ti, err := terminfo.Load(*flagTerm)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: unknown terminal %q\n", os.Args[0], *flagTerm)
+		os.Exit(exitBadTerm)
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+
+	if !*flagStdin {
+		code := run(w, ti, flag.Args())
+		w.Flush()
+		os.Exit(code)
+	}
+
+	// read cap names from stdin, returning the last error
+	code, s := exitOK, bufio.NewScanner(os.Stdin)
+	for s.Scan() {
+		args := strings.Fields(s.Text())
+		if len(args) == 0 {
+			continue
+		}
+		if c := run(w, ti, args); c > exitFalse {
+			code = c
+		}
+	}
+	if err := s.Err(); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", os.Args[0], err)
+		code = exitUsage
+	}
+	w.Flush()
+	os.Exit(code)
+}
+
+// run writes the cap name in args[0], interpolating the params in args[1:],
+// returning the exit code.
+func run(w io.Writer, ti *terminfo.Terminfo, args []string) int {
+	name, params := args[0], convert(args[1:])
+	switch name {
+	case "init", "reset":
+		if err := checkTerminal(); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: terminal attributes: %v\n", os.Args[0], err)
+			return exitNoTerminal
+		}
+		if name == "reset" && hasAny(ti, terminfo.Reset1string, terminfo.Reset2string, terminfo.ResetFile, terminfo.Reset3string) {
+			return writeCaps(w, ti, terminfo.Reset1string, terminfo.Reset2string, terminfo.ResetFile, terminfo.Reset3string)
+		}
+		return writeCaps(w, ti, terminfo.Init1string, terminfo.Init2string, terminfo.InitFile, terminfo.Init3string)
+	case "clear":
+		return writeCaps(w, ti, terminfo.ClearScreen)
+	case "longname":
+		fmt.Fprint(w, ti.Names[len(ti.Names)-1])
+		return exitOK
+	case "cols", "columns", "co":
+		cols, _, ok := getWinsize()
+		fmt.Fprintln(w, size(cols, ok, "COLUMNS", ti.Num(terminfo.Columns)))
+		return exitOK
+	case "lines", "li":
+		_, lines, ok := getWinsize()
+		fmt.Fprintln(w, size(lines, ok, "LINES", ti.Num(terminfo.Lines)))
+		return exitOK
+	}
+
+	kind, i, ok := ti.LookupCap(name)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "%s: unknown terminfo capability '%s'\n", os.Args[0], name)
+		return exitBadCap
+	}
+	switch kind {
+	case terminfo.CapKindBool:
+		return exitCode(ti.Has(i))
+	case terminfo.CapKindExtBool:
+		return exitCode(ti.ExtBools[i])
+	case terminfo.CapKindNum:
+		fmt.Fprintln(w, ti.Num(i))
+		return exitOK
+	case terminfo.CapKindExtNum:
+		fmt.Fprintln(w, ti.ExtNum(name))
+		return exitOK
+	case terminfo.CapKindExtString:
+		s := ti.ExtStrings[i]
+		if s == nil {
+			return exitFalse
+		}
+		io.WriteString(w, ti.StaticVars().Printf(s, params...))
+		return exitOK
+	}
+	if !ti.StringsP.Has(i) {
+		return exitFalse
+	}
+	io.WriteString(w, ti.Printf(i, params...))
+	return exitOK
+}
+
+// writeCaps writes the string caps (without parameters) that are defined to
+// w. The file caps (if and rf) are the names of files whose contents are
+// written.
+func writeCaps(w io.Writer, ti *terminfo.Terminfo, caps ...int) int {
+	for _, i := range caps {
+		if !ti.StringsP.Has(i) {
+			continue
+		}
+		s := ti.Strings[i]
+		if i != terminfo.InitFile && i != terminfo.ResetFile {
+			io.WriteString(w, ti.Printf(i))
+			continue
+		}
+		f, err := os.Open(string(s))
+		if err != nil {
+			continue
+		}
+		io.Copy(w, f)
+		f.Close()
+	}
+	return exitOK
+}
+
+// hasAny determines if any of the string caps are defined.
+func hasAny(ti *terminfo.Terminfo, caps ...int) bool {
+	for _, i := range caps {
+		if ti.StringsP.Has(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// getWinsize returns the columns and lines of the terminal.
+var getWinsize = winsize
+
+// checkTerminal checks that there is a terminal to initialize or reset.
+var checkTerminal = terminal
+
+// size returns the terminal size from the environment variable name, as with
+// ncurses overriding the window size of the terminal z when ok, or the
+// terminfo num n.
+func size(z int, ok bool, name string, n int) int {
+	if v, err := strconv.Atoi(os.Getenv(name)); err == nil && v > 0 {
+		return v
+	}
+	if ok {
+		return z
+	}
+	return n
+}
+
+// convert converts args to params, with numeric args as ints.
+func convert(args []string) []interface{} {
+	params := make([]interface{}, len(args))
+	for i, arg := range args {
+		if n, err := strconv.Atoi(arg); err == nil {
+			params[i] = n
+		} else {
+			params[i] = arg
+		}
+	}
+	return params
+}
+
+// exitCode returns the exit code for bool b.
+func exitCode(b bool) int {
+	if b {
+		return exitOK
+	}
+	return exitFalse
+}
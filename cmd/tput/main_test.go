@@ -0,0 +1,114 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/xo/terminfo"
+)
+
+func TestSize(t *testing.T) {
+	ti, err := terminfo.Open("../../testdata/terminfo", "xterm")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	defer func(f func() (int, int, bool)) { getWinsize = f }(getWinsize)
+	defer os.Setenv("COLUMNS", os.Getenv("COLUMNS"))
+	defer os.Setenv("LINES", os.Getenv("LINES"))
+
+	tests := []struct {
+		winsize     bool
+		cols, lines string
+		exp         [2]string
+	}{
+		// the environment is used first
+		{true, "100", "30", [2]string{"100\n", "30\n"}},
+		{false, "100", "30", [2]string{"100\n", "30\n"}},
+		// then the window size
+		{true, "", "", [2]string{"120\n", "40\n"}},
+		{true, "0", "x", [2]string{"120\n", "40\n"}},
+		{true, "100", "", [2]string{"100\n", "40\n"}},
+		// then the terminfo
+		{false, "", "", [2]string{"80\n", "24\n"}},
+		{false, "0", "", [2]string{"80\n", "24\n"}},
+	}
+	for i, test := range tests {
+		getWinsize = func() (int, int, bool) {
+			if test.winsize {
+				return 120, 40, true
+			}
+			return 0, 0, false
+		}
+		os.Setenv("COLUMNS", test.cols)
+		os.Setenv("LINES", test.lines)
+		for j, name := range []string{"cols", "lines"} {
+			buf := new(bytes.Buffer)
+			if code := run(buf, ti, []string{name}); code != exitOK {
+				t.Errorf("test %d %s expected exit code %d, got: %d", i, name, exitOK, code)
+			}
+			if s := buf.String(); s != test.exp[j] {
+				t.Errorf("test %d %s expected %q, got: %q", i, name, test.exp[j], s)
+			}
+		}
+	}
+}
+
+func TestStringPresent(t *testing.T) {
+	ti, err := terminfo.Open("../../testdata/terminfo", "xterm")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// present
+	buf := new(bytes.Buffer)
+	if code := run(buf, ti, []string{"bold"}); code != exitOK || buf.String() != "\x1b[1m" {
+		t.Errorf("expected exit code %d and %q, got: %d %q", exitOK, "\x1b[1m", code, buf.String())
+	}
+
+	// present but empty
+	ti.Strings[terminfo.EnterBoldMode] = nil
+	buf.Reset()
+	if code := run(buf, ti, []string{"bold"}); code != exitOK || buf.Len() != 0 {
+		t.Errorf("expected exit code %d and no output, got: %d %q", exitOK, code, buf.String())
+	}
+
+	// not present
+	ti.Strings[terminfo.EnterBoldMode] = []byte("\x1b[1m")
+	ti.StringsP.Unset(terminfo.EnterBoldMode)
+	buf.Reset()
+	if code := run(buf, ti, []string{"bold"}); code != exitFalse || buf.Len() != 0 {
+		t.Errorf("expected exit code %d and no output, got: %d %q", exitFalse, code, buf.String())
+	}
+}
+
+func TestInitNoTerminal(t *testing.T) {
+	ti, err := terminfo.Open("../../testdata/terminfo", "xterm")
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	defer func(f func() error) { checkTerminal = f }(checkTerminal)
+
+	for _, name := range []string{"init", "reset"} {
+		// without a terminal
+		checkTerminal = func() error { return syscall.ENXIO }
+		buf := new(bytes.Buffer)
+		if code := run(buf, ti, []string{name}); code != exitNoTerminal {
+			t.Errorf("%s expected exit code %d, got: %d", name, exitNoTerminal, code)
+		}
+		if buf.Len() != 0 {
+			t.Errorf("%s expected no output, got: %q", name, buf.String())
+		}
+
+		// with a terminal
+		checkTerminal = func() error { return nil }
+		buf.Reset()
+		if code := run(buf, ti, []string{name}); code != exitOK {
+			t.Errorf("%s expected exit code %d, got: %d", name, exitOK, code)
+		}
+		if buf.Len() == 0 {
+			t.Errorf("%s expected output", name)
+		}
+	}
+}
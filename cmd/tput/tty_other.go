@@ -0,0 +1,16 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd
+
+package main
+
+// winsize returns the columns and lines of the terminal, which are not
+// available on this platform.
+func winsize() (int, int, bool) {
+	return 0, 0, false
+}
+
+// terminal checks that there is a terminal, which cannot be checked on this
+// platform.
+func terminal() error {
+	return nil
+}
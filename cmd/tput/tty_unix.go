@@ -0,0 +1,58 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd
+// +build darwin dragonfly freebsd linux netbsd
+
+package main
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// winsize returns the columns and lines of the terminal, using the first of
+// stdout, stderr and /dev/tty that is a terminal.
+func winsize() (int, int, bool) {
+	for _, f := range []*os.File{os.Stdout, os.Stderr} {
+		if ws, err := ioctlWinsize(f.Fd()); err == nil && ws.Col != 0 && ws.Row != 0 {
+			return int(ws.Col), int(ws.Row), true
+		}
+	}
+	f, err := os.Open("/dev/tty")
+	if err != nil {
+		return 0, 0, false
+	}
+	defer f.Close()
+	if ws, err := ioctlWinsize(f.Fd()); err == nil && ws.Col != 0 && ws.Row != 0 {
+		return int(ws.Col), int(ws.Row), true
+	}
+	return 0, 0, false
+}
+
+// terminal checks that one of stderr, stdout, stdin or /dev/tty is a
+// terminal, as done by ncurses' tput before init and reset.
+func terminal() error {
+	for _, f := range []*os.File{os.Stderr, os.Stdout, os.Stdin} {
+		if _, err := ioctlWinsize(f.Fd()); err == nil {
+			return nil
+		}
+	}
+	f, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if pe, ok := err.(*os.PathError); ok {
+		return pe.Err
+	} else if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = ioctlWinsize(f.Fd())
+	return err
+}
+
+// ioctlWinsize returns the window size of the terminal fd, using the
+// TIOCGWINSZ ioctl.
+func ioctlWinsize(fd uintptr) (ws struct{ Row, Col, Xpixel, Ypixel uint16 }, err error) {
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, syscall.TIOCGWINSZ, uintptr(unsafe.Pointer(&ws)))
+	if errno != 0 {
+		return ws, errno
+	}
+	return ws, nil
+}
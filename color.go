@@ -78,11 +78,11 @@ func ColorLevelFromEnv() (ColorLevel, error) {
 			return ColorLevelNone, err
 		}
 
-		v, ok := ti.Nums[MaxColors]
+		v := ti.Num(MaxColors)
 		switch {
-		case !ok || v <= 16:
+		case v <= 16:
 			return ColorLevelNone, nil
-		case ok && v >= 256:
+		case v >= 256:
 			return ColorLevelHundreds, nil
 		}
 	}
@@ -0,0 +1,136 @@
+package terminfo
+
+import (
+	"io"
+)
+
+// CursorStyle is a cursor style.
+type CursorStyle uint
+
+// CursorStyle values.
+//
+// The values are the same as the parameter passed to the Ss extended string
+// (DECSCUSR).
+const (
+	CursorStyleDefault CursorStyle = iota
+	CursorStyleBlinkingBlock
+	CursorStyleSteadyBlock
+	CursorStyleBlinkingUnderline
+	CursorStyleSteadyUnderline
+	CursorStyleBlinkingBar
+	CursorStyleSteadyBar
+)
+
+// String satisfies the Stringer interface.
+func (c CursorStyle) String() string {
+	switch c {
+	case CursorStyleBlinkingBlock:
+		return "blinking block"
+	case CursorStyleSteadyBlock:
+		return "steady block"
+	case CursorStyleBlinkingUnderline:
+		return "blinking underline"
+	case CursorStyleSteadyUnderline:
+		return "steady underline"
+	case CursorStyleBlinkingBar:
+		return "blinking bar"
+	case CursorStyleSteadyBar:
+		return "steady bar"
+	}
+	return "default"
+}
+
+// Blinking determines if the cursor style is a blinking style.
+func (c CursorStyle) Blinking() bool {
+	return c == CursorStyleBlinkingBlock ||
+		c == CursorStyleBlinkingUnderline ||
+		c == CursorStyleBlinkingBar
+}
+
+// HasCursorStyle determines if the terminal supports changing the cursor
+// style through the Ss extended string.
+func (ti *Terminfo) HasCursorStyle() bool {
+	return ti.ExtString("Ss") != nil
+}
+
+// CursorStyle returns the string that sets the cursor style to c.
+//
+// When the terminal does not have the Ss extended string, the cvvis string is
+// used for blinking styles and the cnorm string for all others. An empty
+// string is returned when no suitable cap is present.
+func (ti *Terminfo) CursorStyle(c CursorStyle) string {
+	ss := ti.ExtString("Ss")
+	switch {
+	case c == CursorStyleDefault && ti.ExtString("Se") != nil:
+		return string(ti.ExtString("Se"))
+	case ss != nil:
+		return ti.StaticVars().Printf(ss, int(c))
+	case c.Blinking() && ti.StringsP.Has(CursorVisible):
+		return string(ti.Strings[CursorVisible])
+	}
+	return string(ti.str(CursorNormal))
+}
+
+// SetCursorStyle writes the string that sets the cursor style to c to writer
+// w.
+func (ti *Terminfo) SetCursorStyle(w io.Writer, c CursorStyle) error {
+	s := ti.CursorStyle(c)
+	if s == "" {
+		return ErrNoCursorStyle
+	}
+	_, err := io.WriteString(w, s)
+	return err
+}
+
+// ResetCursorStyle writes the string that restores the terminal's default
+// cursor style to writer w.
+func (ti *Terminfo) ResetCursorStyle(w io.Writer) error {
+	return ti.SetCursorStyle(w, CursorStyleDefault)
+}
+
+// HideCursor writes the civis string to writer w.
+func (ti *Terminfo) HideCursor(w io.Writer) error {
+	if !ti.StringsP.Has(CursorInvisible) {
+		return ErrNoCursorStyle
+	}
+	_, err := w.Write(ti.Strings[CursorInvisible])
+	return err
+}
+
+// ShowCursor writes the cnorm string to writer w.
+func (ti *Terminfo) ShowCursor(w io.Writer) error {
+	if !ti.StringsP.Has(CursorNormal) {
+		return ErrNoCursorStyle
+	}
+	_, err := w.Write(ti.Strings[CursorNormal])
+	return err
+}
+
+// HasCursorColor determines if the terminal supports changing the cursor
+// color through the Cs extended string.
+func (ti *Terminfo) HasCursorColor() bool {
+	return ti.ExtString("Cs") != nil
+}
+
+// SetCursorColor writes the string that sets the cursor color to writer w.
+// The color is passed as is to the terminal, and is usually either a color
+// name or a X11 color specification such as "#ff0000" or "rgb:ff/00/00".
+func (ti *Terminfo) SetCursorColor(w io.Writer, color string) error {
+	cs := ti.ExtString("Cs")
+	if cs == nil {
+		return ErrNoCursorColor
+	}
+	_, err := io.WriteString(w, ti.StaticVars().Printf(cs, color))
+	return err
+}
+
+// ResetCursorColor writes the string that restores the terminal's default
+// cursor color to writer w.
+func (ti *Terminfo) ResetCursorColor(w io.Writer) error {
+	cr := ti.ExtString("Cr")
+	if cr == nil {
+		return ErrNoCursorColor
+	}
+	_, err := w.Write(cr)
+	return err
+}
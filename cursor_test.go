@@ -0,0 +1,101 @@
+package terminfo
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCursorStyle(t *testing.T) {
+	ss := withCaps(&Terminfo{
+		ExtStrings:     map[int][]byte{0: []byte("\x1b[%p1%d q"), 1: []byte("\x1b[2 q")},
+		ExtStringNames: map[int][]byte{0: []byte("Ss"), 1: []byte("Se")},
+	}, nil, nil, map[int]string{CursorNormal: "\x1b[?25h"})
+	fallback := withCaps(&Terminfo{}, nil, nil, map[int]string{
+		CursorNormal:  "\x1b[?25h",
+		CursorVisible: "\x1b[?12;25h",
+	})
+	tests := []struct {
+		ti  *Terminfo
+		c   CursorStyle
+		exp string
+	}{
+		{ss, CursorStyleSteadyBar, "\x1b[6 q"},
+		{ss, CursorStyleBlinkingUnderline, "\x1b[3 q"},
+		{ss, CursorStyleDefault, "\x1b[2 q"},
+		{fallback, CursorStyleSteadyBar, "\x1b[?25h"},
+		{fallback, CursorStyleBlinkingBlock, "\x1b[?12;25h"},
+		{fallback, CursorStyleDefault, "\x1b[?25h"},
+		{&Terminfo{}, CursorStyleSteadyBar, ""},
+	}
+	for i, test := range tests {
+		buf := new(bytes.Buffer)
+		err := test.ti.SetCursorStyle(buf, test.c)
+		switch {
+		case test.exp == "" && err != ErrNoCursorStyle:
+			t.Errorf("test %d expected ErrNoCursorStyle, got: %v", i, err)
+		case test.exp != "" && err != nil:
+			t.Errorf("test %d expected no error, got: %v", i, err)
+		case buf.String() != test.exp:
+			t.Errorf("test %d (%s) expected %q, got: %q", i, test.c, test.exp, buf.String())
+		}
+	}
+}
+
+func TestShowHideCursor(t *testing.T) {
+	// presence is determined by the present set, not the string value
+	empty := new(Terminfo)
+	empty.StringsP.Set(CursorInvisible)
+	empty.StringsP.Set(CursorNormal)
+	unset := withCaps(&Terminfo{}, nil, nil, map[int]string{
+		CursorInvisible: "\x1b[?25l",
+		CursorNormal:    "\x1b[?25h",
+	})
+	unset.StringsP.Unset(CursorInvisible)
+	unset.StringsP.Unset(CursorNormal)
+	tests := []struct {
+		ti         *Terminfo
+		err        error
+		hide, show string
+	}{
+		{withCaps(&Terminfo{}, nil, nil, map[int]string{CursorInvisible: "\x1b[?25l", CursorNormal: "\x1b[?25h"}), nil, "\x1b[?25l", "\x1b[?25h"},
+		{empty, nil, "", ""},
+		{unset, ErrNoCursorStyle, "", ""},
+		{&Terminfo{}, ErrNoCursorStyle, "", ""},
+	}
+	for i, test := range tests {
+		buf := new(bytes.Buffer)
+		if err := test.ti.HideCursor(buf); err != test.err {
+			t.Errorf("test %d hide expected error %v, got: %v", i, test.err, err)
+		}
+		if s := buf.String(); s != test.hide {
+			t.Errorf("test %d hide expected %q, got: %q", i, test.hide, s)
+		}
+		buf.Reset()
+		if err := test.ti.ShowCursor(buf); err != test.err {
+			t.Errorf("test %d show expected error %v, got: %v", i, test.err, err)
+		}
+		if s := buf.String(); s != test.show {
+			t.Errorf("test %d show expected %q, got: %q", i, test.show, s)
+		}
+	}
+}
+
+func TestCursorColor(t *testing.T) {
+	ti := &Terminfo{
+		ExtStrings:     map[int][]byte{0: []byte("\x1b]12;%p1%s\a"), 1: []byte("\x1b]112\a")},
+		ExtStringNames: map[int][]byte{0: []byte("Cs"), 1: []byte("Cr")},
+	}
+	buf := new(bytes.Buffer)
+	if err := ti.SetCursorColor(buf, "#ff0000"); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if err := ti.ResetCursorColor(buf); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if exp := "\x1b]12;#ff0000\a\x1b]112\a"; buf.String() != exp {
+		t.Errorf("expected %q, got: %q", exp, buf.String())
+	}
+	if err := (&Terminfo{}).SetCursorColor(buf, "red"); err != ErrNoCursorColor {
+		t.Errorf("expected ErrNoCursorColor, got: %v", err)
+	}
+}
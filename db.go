@@ -0,0 +1,215 @@
+package terminfo
+
+import (
+	"io/ioutil"
+	"path"
+	"sort"
+	"strconv"
+)
+
+// Entry is an entry in a terminfo database directory.
+type Entry struct {
+	// Name is the entry's file name, which is either the entry's primary name
+	// or one of its aliases.
+	Name string
+
+	// Dir is the database directory.
+	Dir string
+
+	// File is the path to the entry's file.
+	File string
+}
+
+// ListDir returns the entries in the database directory dir, sorted by name.
+// Both the letter (x/xterm) and hex (78/xterm) directory layouts are listed,
+// and when an entry is in both, only the file Open would read is returned.
+// As with ncurses' toe, subdirectories that cannot be read are skipped.
+func ListDir(dir string) ([]Entry, error) {
+	subdirs, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var entries []Entry
+	for _, layout := range []func(byte) string{
+		func(c byte) string { return string(c) },
+		func(c byte) string { return strconv.FormatUint(uint64(c), 16) },
+	} {
+		for _, sd := range subdirs {
+			if !sd.IsDir() {
+				continue
+			}
+			files, err := ioutil.ReadDir(path.Join(dir, sd.Name()))
+			if err != nil {
+				continue
+			}
+			for _, fi := range files {
+				name := fi.Name()
+				if fi.IsDir() || seen[name] || layout(name[0]) != sd.Name() {
+					continue
+				}
+				seen[name] = true
+				entries = append(entries, Entry{
+					Name: name,
+					Dir:  dir,
+					File: path.Join(dir, sd.Name(), name),
+				})
+			}
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+
+	return entries, nil
+}
+
+// List returns the entries reachable through the directories searched by
+// Load, sorted by name. When an entry is in more than one directory, only the
+// entry Load would read is returned.
+func List() ([]Entry, error) {
+	all, err := ListAll()
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var entries []Entry
+	for _, e := range all {
+		if !seen[e.Name] {
+			seen[e.Name], entries = true, append(entries, e)
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+
+	return entries, nil
+}
+
+// ListAll returns the entries in each of the directories searched by Load, in
+// search order. As with ncurses' toe, directories that do not exist or cannot
+// be read, and search path entries that are not directories (such as a hashed
+// database file), are skipped.
+func ListAll() ([]Entry, error) {
+	var entries []Entry
+	for _, dir := range SearchPath() {
+		e, err := ListDir(dir)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, e...)
+	}
+
+	return entries, nil
+}
+
+// Walk calls fn with the primary name and decoded terminfo of each entry
+// reachable through the directories searched by Load, in name order. When no
+// file has the primary name, the name of the entry's file is used instead, so
+// that the name can always be passed to Load. Aliases of an entry already
+// walked and entries that cannot be decoded are skipped. Walk stops at the
+// first error returned by fn, and returns it.
+//
+// Entries are decoded without being added to the cache used by Load.
+func Walk(fn func(name string, ti *Terminfo) error) error {
+	entries, err := List()
+	if err != nil {
+		return err
+	}
+
+	files := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		files[e.Name] = true
+	}
+
+	seen := make(map[string]bool)
+	for _, e := range entries {
+		ti, err := readEntry(e)
+		if err != nil || len(ti.Names) == 0 || seen[ti.Names[0]] {
+			continue
+		}
+		seen[ti.Names[0]] = true
+		name := ti.Names[0]
+		if !files[name] {
+			name = e.Name
+		}
+		if err := fn(name, ti); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readEntry reads and decodes the entry's file.
+func readEntry(e Entry) (*Terminfo, error) {
+	buf, err := ioutil.ReadFile(e.File)
+	if err != nil {
+		return nil, err
+	}
+	ti, err := Decode(buf)
+	if err != nil {
+		return nil, err
+	}
+	ti.File = e.File
+	return ti, nil
+}
+
+// Filter is a terminfo filter used by Find.
+type Filter func(ti *Terminfo) bool
+
+// HasCap returns a filter matching terminfos that have the capability name
+// present. The name can be a long, short, termcap or extended name.
+func HasCap(name string) Filter {
+	return func(ti *Terminfo) bool {
+		return ti.CapState(name) == CapPresent
+	}
+}
+
+// CapEquals returns a filter matching terminfos that have the capability name
+// present with value v, which is a bool, int, string or []byte.
+func CapEquals(name string, v interface{}) Filter {
+	if s, ok := v.(string); ok {
+		v = []byte(s)
+	}
+	return func(ti *Terminfo) bool {
+		kind, i, ok := ti.LookupCap(name)
+		return ok && ti.State(kind, i) == CapPresent && equalCapValue(ti.capValue(kind, i), v)
+	}
+}
+
+// CapAtLeast returns a filter matching terminfos that have the num
+// capability name present with a value of at least n.
+func CapAtLeast(name string, n int) Filter {
+	return func(ti *Terminfo) bool {
+		kind, i, ok := ti.LookupCap(name)
+		if !ok || ti.State(kind, i) != CapPresent {
+			return false
+		}
+		v, ok := ti.capValue(kind, i).(int)
+		return ok && v >= n
+	}
+}
+
+// Find returns the terminfos reachable through the directories searched by
+// Load that match all filters, in name order.
+func Find(filters ...Filter) ([]*Terminfo, error) {
+	var tis []*Terminfo
+	err := Walk(func(_ string, ti *Terminfo) error {
+		for _, f := range filters {
+			if !f(ti) {
+				return nil
+			}
+		}
+		tis = append(tis, ti)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tis, nil
+}
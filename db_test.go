@@ -0,0 +1,194 @@
+package terminfo
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// writeEntries writes buf to each of the files in dir.
+func writeEntries(t *testing.T, dir string, buf []byte, files ...string) {
+	for _, file := range files {
+		file = filepath.Join(dir, file)
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(file, buf, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestListDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "terminfo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeEntries(t, dir, stateEntry, "z/zz-a", "7a/zz-a", "7a/zz-b", "y/zz-c", "z/zz-d")
+
+	entries, err := ListDir(dir)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	exp := []Entry{
+		{"zz-a", dir, filepath.Join(dir, "z/zz-a")},
+		{"zz-b", dir, filepath.Join(dir, "7a/zz-b")},
+		{"zz-d", dir, filepath.Join(dir, "z/zz-d")},
+	}
+	if !reflect.DeepEqual(entries, exp) {
+		t.Errorf("expected:\n%v\ngot:\n%v", exp, entries)
+	}
+}
+
+func TestListUnreadable(t *testing.T) {
+	a, err := ioutil.TempDir("", "terminfo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(a)
+	b, err := ioutil.TempDir("", "terminfo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(b)
+	writeEntries(t, a, stateEntry, "b/bb-a", "z/zz-b")
+	// a hashed database file
+	db := filepath.Join(b, "terminfo.db")
+	if err := ioutil.WriteFile(db, stateEntry, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	defer setenv("TERMINFO", db, "TERMINFO_DIRS", a)()
+
+	// non-directory search path entries are skipped
+	entries, err := ListAll()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if len(entries) == 0 || entries[0].File != filepath.Join(a, "b/bb-a") {
+		t.Errorf("expected entries from %s, got: %v", a, entries)
+	}
+
+	// unreadable subdirectories are skipped
+	if os.Geteuid() == 0 {
+		t.Skip("cannot test unreadable directories as root")
+	}
+	if err := os.Chmod(filepath.Join(a, "z"), 0); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chmod(filepath.Join(a, "z"), 0755)
+	entries, err = ListDir(a)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if exp := []Entry{{"bb-a", a, filepath.Join(a, "b/bb-a")}}; !reflect.DeepEqual(entries, exp) {
+		t.Errorf("expected:\n%v\ngot:\n%v", exp, entries)
+	}
+	if _, err := List(); err != nil {
+		t.Errorf("expected no error, got: %v", err)
+	}
+}
+
+func TestList(t *testing.T) {
+	a, err := ioutil.TempDir("", "terminfo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(a)
+	b, err := ioutil.TempDir("", "terminfo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(b)
+	writeEntries(t, a, stateEntry, "z/zz-a")
+	writeEntries(t, b, stateEntry, "z/zz-a", "z/zz-b")
+
+	defer os.Setenv("TERMINFO", os.Getenv("TERMINFO"))
+	defer os.Setenv("TERMINFO_DIRS", os.Getenv("TERMINFO_DIRS"))
+	os.Setenv("TERMINFO", a)
+	os.Setenv("TERMINFO_DIRS", b)
+
+	entries, err := List()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	var found []Entry
+	for _, e := range entries {
+		if e.Name == "zz-a" || e.Name == "zz-b" {
+			found = append(found, e)
+		}
+	}
+	exp := []Entry{
+		{"zz-a", a, filepath.Join(a, "z/zz-a")},
+		{"zz-b", b, filepath.Join(b, "z/zz-b")},
+	}
+	if !reflect.DeepEqual(found, exp) {
+		t.Errorf("expected:\n%v\ngot:\n%v", exp, found)
+	}
+}
+
+func TestWalk(t *testing.T) {
+	dir, err := ioutil.TempDir("", "terminfo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeEntries(t, dir, stateEntry, "x/x", "78/x", "t/test")
+
+	defer os.Setenv("TERMINFO", os.Getenv("TERMINFO"))
+	os.Setenv("TERMINFO", dir)
+
+	var files []string
+	err = Walk(func(name string, ti *Terminfo) error {
+		if name == "x" || name == "test" {
+			files = append(files, ti.File)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if exp := []string{filepath.Join(dir, "t/test")}; !reflect.DeepEqual(files, exp) {
+		t.Errorf("expected %v, got: %v", exp, files)
+	}
+
+	// stop on error
+	err = Walk(func(string, *Terminfo) error { return ErrFileNotFound })
+	if err != ErrFileNotFound {
+		t.Errorf("expected ErrFileNotFound, got: %v", err)
+	}
+}
+
+func TestFilters(t *testing.T) {
+	ti, err := Decode(stateEntry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		f   Filter
+		exp bool
+	}{
+		{HasCap("bw"), true},
+		{HasCap("am"), false},
+		{HasCap("Sd"), true},
+		{HasCap("Sc"), false},
+		{CapEquals("it", 80), true},
+		{CapEquals("it", 81), false},
+		{CapEquals("bel", "\x1b[H"), true},
+		{CapEquals("Sd", []byte("xy")), true},
+		{CapEquals("Nb", 3), true},
+		{CapEquals("Ba", true), true},
+		{CapAtLeast("it", 8), true},
+		{CapAtLeast("Nb", 4), false},
+		{CapAtLeast("bel", 0), false},
+		{CapAtLeast("cols", 0), false},
+	}
+	for i, test := range tests {
+		if b := test.f(ti); b != test.exp {
+			t.Errorf("test %d expected %t, got: %t", i, test.exp, b)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package terminfo
+
+import (
+	"bytes"
+	"sort"
+)
+
+// DiffType is the type of a difference between two capabilities.
+type DiffType uint
+
+// DiffType values.
+const (
+	// DiffOnlyA is a capability only defined (present or cancelled) by the
+	// first terminfo.
+	DiffOnlyA DiffType = iota
+
+	// DiffOnlyB is a capability only defined (present or cancelled) by the
+	// second terminfo.
+	DiffOnlyB
+
+	// DiffChanged is a capability defined by both terminfos with a different
+	// state or value.
+	DiffChanged
+)
+
+// String satisfies the Stringer interface.
+func (t DiffType) String() string {
+	switch t {
+	case DiffOnlyA:
+		return "only in a"
+	case DiffOnlyB:
+		return "only in b"
+	}
+	return "changed"
+}
+
+// CapDiff describes a capability that differs between two terminfos.
+type CapDiff struct {
+	// Name is the long name of a standard capability, or the name of an
+	// extended capability.
+	Name string
+
+	// Kind is the capability kind.
+	Kind CapKind
+
+	// Type is the difference type.
+	Type DiffType
+
+	// StateA is the capability state in the first terminfo.
+	StateA CapState
+
+	// StateB is the capability state in the second terminfo.
+	StateB CapState
+
+	// A is the capability value in the first terminfo (a bool, int or
+	// []byte), or nil if not present.
+	A interface{}
+
+	// B is the capability value in the second terminfo (a bool, int or
+	// []byte), or nil if not present.
+	B interface{}
+}
+
+// Diff returns the capabilities that differ between a and b.
+//
+// The differences are ordered by kind (bools, nums, strings, and then the
+// extended bools, nums and strings), then by index for standard capabilities
+// and by name for extended capabilities. Extended capabilities are compared by
+// name and kind.
+func Diff(a, b *Terminfo) []CapDiff {
+	var diffs []CapDiff
+	add := func(name string, kind CapKind, i, j int, okA, okB bool) {
+		sa, sb := CapAbsent, CapAbsent
+		if okA {
+			sa = a.State(kind, i)
+		}
+		if okB {
+			sb = b.State(kind, j)
+		}
+		d := CapDiff{Name: name, Kind: kind, StateA: sa, StateB: sb}
+		if sa == CapPresent {
+			d.A = a.capValue(kind, i)
+		}
+		if sb == CapPresent {
+			d.B = b.capValue(kind, j)
+		}
+		switch {
+		case sa == CapAbsent && sb == CapAbsent:
+			return
+		case sb == CapAbsent:
+			d.Type = DiffOnlyA
+		case sa == CapAbsent:
+			d.Type = DiffOnlyB
+		case sa == sb && equalCapValue(d.A, d.B):
+			return
+		default:
+			d.Type = DiffChanged
+		}
+		diffs = append(diffs, d)
+	}
+
+	// standard caps
+	for i := 0; i < CapCountBool; i++ {
+		add(BoolCapName(i), CapKindBool, i, i, true, true)
+	}
+	for i := 0; i < CapCountNum; i++ {
+		add(NumCapName(i), CapKindNum, i, i, true, true)
+	}
+	for i := 0; i < CapCountString; i++ {
+		add(StringCapName(i), CapKindString, i, i, true, true)
+	}
+
+	// extended caps
+	for _, kind := range []CapKind{CapKindExtBool, CapKindExtNum, CapKindExtString} {
+		na, nb := extNameIndex(a, kind), extNameIndex(b, kind)
+		var names []string
+		for name := range na {
+			names = append(names, name)
+		}
+		for name := range nb {
+			if _, ok := na[name]; !ok {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			i, okA := na[name]
+			j, okB := nb[name]
+			add(name, kind, i, j, okA, okB)
+		}
+	}
+
+	return diffs
+}
+
+// capValue returns the value of the cap i of kind.
+func (ti *Terminfo) capValue(kind CapKind, i int) interface{} {
+	switch kind {
+	case CapKindBool:
+		return ti.Bools[i]
+	case CapKindNum:
+		return ti.Nums[i]
+	case CapKindString:
+		return ti.Strings[i]
+	case CapKindExtBool:
+		return ti.ExtBools[i]
+	case CapKindExtNum:
+		return ti.ExtNums[i]
+	case CapKindExtString:
+		return ti.ExtStrings[i]
+	}
+	return nil
+}
+
+// extNameIndex returns the map of extended capability names of kind to their
+// index. When a name is defined more than once, the first definition wins.
+func extNameIndex(ti *Terminfo, kind CapKind) map[string]int {
+	m := make(map[string]int)
+	for _, c := range ti.ExtCaps() {
+		if _, ok := m[c.Name]; !ok && c.Kind == kind {
+			m[c.Name] = c.Index
+		}
+	}
+	return m
+}
+
+// equalCapValue determines if the capability values a and b are equal.
+func equalCapValue(a, b interface{}) bool {
+	if x, ok := a.([]byte); ok {
+		y, ok := b.([]byte)
+		return ok && bytes.Equal(x, y)
+	}
+	return a == b
+}
@@ -0,0 +1,39 @@
+package terminfo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	a := withCaps(&Terminfo{
+		ExtBools:       map[int]bool{0: true},
+		ExtBoolNames:   map[int][]byte{0: []byte("XT")},
+		ExtStrings:     map[int][]byte{0: []byte("\x1b[%p1%d q"), 1: []byte("\x1b[2 q")},
+		ExtStringNames: map[int][]byte{0: []byte("Ss"), 1: []byte("Se")},
+	}, []int{AutoRightMargin}, map[int]int{Columns: 80, MaxColors: 8}, map[int]string{Bell: "\a"})
+	a.StringsM.Set(CarriageReturn)
+	b := withCaps(&Terminfo{
+		ExtBools:       map[int]bool{0: true},
+		ExtBoolNames:   map[int][]byte{0: []byte("XT")},
+		ExtNums:        map[int]int{0: 1},
+		ExtNumNames:    map[int][]byte{0: []byte("U8")},
+		ExtStrings:     map[int][]byte{0: []byte("\x1b[0 q"), 1: []byte("\x1b[%p1%d q")},
+		ExtStringNames: map[int][]byte{0: []byte("Se"), 1: []byte("Ss")},
+	}, []int{AutoRightMargin, AutoLeftMargin}, map[int]int{Columns: 80, MaxColors: 256}, map[int]string{CarriageReturn: "\r"})
+
+	exp := []CapDiff{
+		{"auto_left_margin", CapKindBool, DiffOnlyB, CapAbsent, CapPresent, nil, true},
+		{"max_colors", CapKindNum, DiffChanged, CapPresent, CapPresent, 8, 256},
+		{"bell", CapKindString, DiffOnlyA, CapPresent, CapAbsent, []byte("\a"), nil},
+		{"carriage_return", CapKindString, DiffChanged, CapCancelled, CapPresent, nil, []byte("\r")},
+		{"U8", CapKindExtNum, DiffOnlyB, CapAbsent, CapPresent, nil, 1},
+		{"Se", CapKindExtString, DiffChanged, CapPresent, CapPresent, []byte("\x1b[2 q"), []byte("\x1b[0 q")},
+	}
+	if d := Diff(a, b); !reflect.DeepEqual(d, exp) {
+		t.Errorf("expected:\n%v\ngot:\n%v", exp, d)
+	}
+	if d := Diff(a, a.Clone()); len(d) != 0 {
+		t.Errorf("expected no differences, got: %v", d)
+	}
+}
@@ -0,0 +1,309 @@
+package terminfo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// OpKind is the kind of an operation in a parameterized string.
+type OpKind uint
+
+// OpKind values.
+const (
+	// OpText outputs literal text.
+	OpText OpKind = iota
+
+	// OpOutput pops a value and outputs it using a printf style format.
+	OpOutput
+
+	// OpPushParam pushes a parameter.
+	OpPushParam
+
+	// OpPushInt pushes an integer constant.
+	OpPushInt
+
+	// OpPushChar pushes a character constant.
+	OpPushChar
+
+	// OpSetVar pops a value into a variable.
+	OpSetVar
+
+	// OpGetVar pushes the value of a variable.
+	OpGetVar
+
+	// OpStrlen pops a string and pushes its length.
+	OpStrlen
+
+	// OpBinary pops two values and pushes the result of an arithmetic, bit,
+	// comparison or logical operator.
+	OpBinary
+
+	// OpUnary pops a value and pushes the result of a bit or logical
+	// operator.
+	OpUnary
+
+	// OpIncrement increments the first two parameters.
+	OpIncrement
+
+	// OpIf begins a conditional.
+	OpIf
+
+	// OpThen pops a value, and continues when it is true.
+	OpThen
+
+	// OpElse begins the else (or else if) part of a conditional.
+	OpElse
+
+	// OpEndIf ends a conditional.
+	OpEndIf
+
+	// OpInvalid is an invalid or truncated operation.
+	OpInvalid
+)
+
+// String satisfies the Stringer interface.
+func (k OpKind) String() string {
+	switch k {
+	case OpText:
+		return "text"
+	case OpOutput:
+		return "output"
+	case OpPushParam:
+		return "push param"
+	case OpPushInt:
+		return "push int"
+	case OpPushChar:
+		return "push char"
+	case OpSetVar:
+		return "set var"
+	case OpGetVar:
+		return "get var"
+	case OpStrlen:
+		return "strlen"
+	case OpBinary:
+		return "binary"
+	case OpUnary:
+		return "unary"
+	case OpIncrement:
+		return "increment"
+	case OpIf:
+		return "if"
+	case OpThen:
+		return "then"
+	case OpElse:
+		return "else"
+	case OpEndIf:
+		return "end if"
+	}
+	return "invalid"
+}
+
+// Op is a disassembled operation of a parameterized string.
+type Op struct {
+	// Kind is the operation kind.
+	Kind OpKind
+
+	// Pos is the position of the operation in the string.
+	Pos int
+
+	// Code is the source of the operation, such as "%p1" or literal text.
+	Code string
+
+	// Arg is the operation's argument: the text for OpText, the format for
+	// OpOutput, the parameter number for OpPushParam, the constant for
+	// OpPushInt and OpPushChar, the variable name for OpSetVar and OpGetVar,
+	// and the operator for OpBinary and OpUnary.
+	Arg string
+
+	// Nest is the conditional nesting level of the operation.
+	Nest int
+}
+
+// opNames are the descriptions of the binary and unary operators.
+var opNames = map[string]string{
+	"+": "add",
+	"-": "subtract",
+	"*": "multiply",
+	"/": "divide",
+	"m": "modulo",
+	"&": "bitwise and",
+	"|": "bitwise or",
+	"^": "bitwise xor",
+	"=": "equal",
+	">": "greater than",
+	"<": "less than",
+	"A": "logical and",
+	"O": "logical or",
+	"!": "logical not",
+	"~": "bitwise not",
+}
+
+// String satisfies the Stringer interface, returning a description of the
+// operation.
+func (op Op) String() string {
+	switch op.Kind {
+	case OpText:
+		return fmt.Sprintf("text %q", op.Arg)
+	case OpOutput:
+		switch {
+		case strings.HasSuffix(op.Arg, "s"):
+			return "pop string, output as " + op.Arg
+		case strings.HasSuffix(op.Arg, "c"):
+			return "pop char, output as " + op.Arg
+		}
+		return "pop int, output as " + op.Arg
+	case OpPushParam:
+		return "push param " + op.Arg
+	case OpPushInt:
+		return "push int " + op.Arg
+	case OpPushChar:
+		if op.Arg == "" {
+			return "push char"
+		}
+		return "push char " + fmt.Sprintf("%q", op.Arg[0])
+	case OpSetVar:
+		return "pop into " + varKind(op.Arg) + " var " + op.Arg
+	case OpGetVar:
+		return "push " + varKind(op.Arg) + " var " + op.Arg
+	case OpStrlen:
+		return "pop string, push length"
+	case OpBinary:
+		return "pop 2, push " + opNames[op.Arg]
+	case OpUnary:
+		return "pop 1, push " + opNames[op.Arg]
+	case OpIncrement:
+		return "increment params 1 and 2"
+	case OpIf, OpThen, OpElse, OpEndIf:
+		return op.Kind.String()
+	}
+	return "invalid " + fmt.Sprintf("%q", op.Code)
+}
+
+// varKind returns the kind of the variable name.
+func varKind(name string) string {
+	if name != "" && name[0] >= 'A' && name[0] <= 'Z' {
+		return "static"
+	}
+	return "dynamic"
+}
+
+// Disassemble disassembles the parameterized string z into its operations.
+func Disassemble(z []byte) []Op {
+	var ops []Op
+	var nest int
+	add := func(kind OpKind, start, end int, arg string) {
+		n := nest
+		switch kind {
+		case OpIf:
+			nest++
+		case OpThen, OpElse:
+			n--
+		case OpEndIf:
+			if nest > 0 {
+				nest--
+			}
+			n = nest
+		}
+		if n < 0 {
+			n = 0
+		}
+		ops = append(ops, Op{Kind: kind, Pos: start, Code: string(z[start:end]), Arg: arg, Nest: n})
+	}
+
+	for pos := 0; pos < len(z); {
+		// text
+		if z[pos] != '%' {
+			end := pos
+			for end < len(z) && z[end] != '%' {
+				end++
+			}
+			add(OpText, pos, end, string(z[pos:end]))
+			pos = end
+			continue
+		}
+
+		// operation, with the format scanned before every operator, as done
+		// by Printf
+		start := pos
+		f, end := scanFormat(z, pos+1)
+		if pos = end; pos >= len(z) {
+			add(OpInvalid, start, pos, "")
+			break
+		}
+		ch := z[pos]
+		pos++
+		switch {
+		case ch == '%':
+			add(OpText, start, pos, "%")
+		case ch == 'c':
+			// the format is ignored for chars
+			add(OpOutput, start, pos, "%c")
+		case strings.IndexByte("doxXs", ch) != -1:
+			add(OpOutput, start, pos, f.String()+string(ch))
+		case ch == 'p':
+			if pos >= len(z) || z[pos] < '1' || z[pos] > '9' {
+				add(OpInvalid, start, pos, "")
+				continue
+			}
+			pos++
+			add(OpPushParam, start, pos, string(z[pos-1]))
+		case ch == 'P' || ch == 'g':
+			if pos >= len(z) || !isVarName(z[pos]) {
+				add(OpInvalid, start, pos, "")
+				continue
+			}
+			pos++
+			kind := OpSetVar
+			if ch == 'g' {
+				kind = OpGetVar
+			}
+			add(kind, start, pos, string(z[pos-1]))
+		case ch == '\'':
+			if pos+1 >= len(z) || z[pos+1] != '\'' {
+				add(OpInvalid, start, len(z), "")
+				pos = len(z)
+				continue
+			}
+			pos += 2
+			add(OpPushChar, start, pos, string(z[pos-2]))
+		case ch == '{':
+			end := pos
+			for end < len(z) && z[end] >= '0' && z[end] <= '9' {
+				end++
+			}
+			if end == pos || end >= len(z) || z[end] != '}' {
+				add(OpInvalid, start, end, "")
+				pos = end
+				continue
+			}
+			arg := string(z[pos:end])
+			pos = end + 1
+			add(OpPushInt, start, pos, arg)
+		case ch == 'l':
+			add(OpStrlen, start, pos, "")
+		case ch == '!' || ch == '~':
+			add(OpUnary, start, pos, string(ch))
+		case strings.IndexByte("+-*/m&|^=><AO", ch) != -1:
+			add(OpBinary, start, pos, string(ch))
+		case ch == 'i':
+			add(OpIncrement, start, pos, "")
+		case ch == '?':
+			add(OpIf, start, pos, "")
+		case ch == 't':
+			add(OpThen, start, pos, "")
+		case ch == 'e':
+			add(OpElse, start, pos, "")
+		case ch == ';':
+			add(OpEndIf, start, pos, "")
+		default:
+			add(OpInvalid, start, pos, "")
+		}
+	}
+
+	return ops
+}
+
+// isVarName determines if c is a valid variable name.
+func isVarName(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,93 @@
+package terminfo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDisassemble(t *testing.T) {
+	tests := []struct {
+		s   string
+		exp []Op
+	}{
+		{"\x1b[%i%p1%d;%p2%dH", []Op{
+			{OpText, 0, "\x1b[", "\x1b[", 0},
+			{OpIncrement, 2, "%i", "", 0},
+			{OpPushParam, 4, "%p1", "1", 0},
+			{OpOutput, 7, "%d", "%d", 0},
+			{OpText, 9, ";", ";", 0},
+			{OpPushParam, 10, "%p2", "2", 0},
+			{OpOutput, 13, "%d", "%d", 0},
+			{OpText, 15, "H", "H", 0},
+		}},
+		{"%?%p1%{8}%<%t3%p1%d%e%p1%:-3.2x%;", []Op{
+			{OpIf, 0, "%?", "", 0},
+			{OpPushParam, 2, "%p1", "1", 1},
+			{OpPushInt, 5, "%{8}", "8", 1},
+			{OpBinary, 9, "%<", "<", 1},
+			{OpThen, 11, "%t", "", 0},
+			{OpText, 13, "3", "3", 1},
+			{OpPushParam, 14, "%p1", "1", 1},
+			{OpOutput, 17, "%d", "%d", 1},
+			{OpElse, 19, "%e", "", 0},
+			{OpPushParam, 21, "%p1", "1", 1},
+			{OpOutput, 24, "%:-3.2x", "%-3.2x", 1},
+			{OpEndIf, 31, "%;", "", 0},
+		}},
+		{"%'a'%Pa%gA%l%!%%%02d%z%{1", []Op{
+			{OpPushChar, 0, "%'a'", "a", 0},
+			{OpSetVar, 4, "%Pa", "a", 0},
+			{OpGetVar, 7, "%gA", "A", 0},
+			{OpStrlen, 10, "%l", "", 0},
+			{OpUnary, 12, "%!", "!", 0},
+			{OpText, 14, "%%", "%", 0},
+			{OpOutput, 16, "%02d", "%02d", 0},
+			{OpInvalid, 20, "%z", "", 0},
+			{OpInvalid, 22, "%{1", "", 0},
+		}},
+		// formats are scanned as done by Printf
+		{"%:+d%#x%5c%20000d%5.3.2d%5%%5z%:", []Op{
+			{OpBinary, 0, "%:+", "+", 0},
+			{OpText, 3, "d", "d", 0},
+			{OpOutput, 4, "%#x", "%#x", 0},
+			{OpOutput, 7, "%5c", "%c", 0},
+			{OpOutput, 10, "%20000d", "%d", 0},
+			{OpOutput, 17, "%5.3.2d", "%d", 0},
+			{OpText, 24, "%5%", "%", 0},
+			{OpInvalid, 27, "%5z", "", 0},
+			{OpInvalid, 30, "%:", "", 0},
+		}},
+		{"%: -#012.3s", []Op{
+			{OpOutput, 0, "%: -#012.3s", "%-# 012.3s", 0},
+		}},
+	}
+	for i, test := range tests {
+		if ops := Disassemble([]byte(test.s)); !reflect.DeepEqual(ops, test.exp) {
+			t.Errorf("test %d expected:\n%v\ngot:\n%v", i, test.exp, ops)
+		}
+	}
+}
+
+func TestOpString(t *testing.T) {
+	tests := []struct {
+		op  Op
+		exp string
+	}{
+		{Op{Kind: OpPushParam, Arg: "1"}, "push param 1"},
+		{Op{Kind: OpOutput, Arg: "%2d"}, "pop int, output as %2d"},
+		{Op{Kind: OpOutput, Arg: "%s"}, "pop string, output as %s"},
+		{Op{Kind: OpSetVar, Arg: "A"}, "pop into static var A"},
+		{Op{Kind: OpGetVar, Arg: "b"}, "push dynamic var b"},
+		{Op{Kind: OpBinary, Arg: "<"}, "pop 2, push less than"},
+		{Op{Kind: OpText, Arg: "\x1b["}, `text "\x1b["`},
+		{Op{Kind: OpPushChar, Arg: "a"}, "push char 'a'"},
+		// zero length args
+		{Op{Kind: OpOutput}, "pop int, output as "},
+		{Op{Kind: OpPushChar}, "push char"},
+	}
+	for _, test := range tests {
+		if s := test.op.String(); s != test.exp {
+			t.Errorf("expected %q, got: %q", test.exp, s)
+		}
+	}
+}
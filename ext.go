@@ -0,0 +1,153 @@
+package terminfo
+
+import (
+	"io"
+	"sync"
+)
+
+// ExtCap describes an extended capability.
+type ExtCap struct {
+	// Name is the capability name.
+	Name string
+
+	// Kind is the capability kind.
+	Kind CapKind
+
+	// Index is the index of the capability in the extended capabilities of
+	// the same kind.
+	Index int
+}
+
+// extIndex is the index of extended capability names to their kind and
+// index, which is rebuilt when the extended capability names are changed
+// after it was built.
+type extIndex struct {
+	sync.Mutex
+
+	// m is the map of names to their kind and index.
+	m map[string]capIndex
+}
+
+// indexExt builds the index of extended capability names.
+func (ti *Terminfo) indexExt() {
+	ti.extIndex = new(extIndex)
+	ti.extIndex.build(ti)
+}
+
+// build builds the index for the extended capability names of ti. When a
+// name is defined more than once, the first definition wins.
+func (x *extIndex) build(ti *Terminfo) {
+	x.m = make(map[string]capIndex, len(ti.ExtBoolNames)+len(ti.ExtNumNames)+len(ti.ExtStringNames))
+	for _, c := range ti.ExtCaps() {
+		if _, ok := x.m[c.Name]; !ok {
+			x.m[c.Name] = capIndex{c.Kind, c.Index}
+		}
+	}
+}
+
+// extNames returns the extended capability names for kind.
+func (ti *Terminfo) extNames(kind CapKind) map[int][]byte {
+	switch kind {
+	case CapKindExtBool:
+		return ti.ExtBoolNames
+	case CapKindExtNum:
+		return ti.ExtNumNames
+	case CapKindExtString:
+		return ti.ExtStringNames
+	}
+	return nil
+}
+
+// lookupExt returns the kind and index of the extended capability name.
+//
+// As the name maps can be edited after the index was built, a name found in
+// the index is checked against the name map, and a name not found in the
+// index is searched for in the name maps, rebuilding the index when it is
+// stale.
+func (ti *Terminfo) lookupExt(name string) (CapKind, int, bool) {
+	x := ti.extIndex
+	if x == nil {
+		// not decoded, so search the names
+		return ti.searchExt(name)
+	}
+	x.Lock()
+	defer x.Unlock()
+	c, ok := x.m[name]
+	switch {
+	case ok && string(ti.extNames(c.kind)[c.index]) == name:
+		return c.kind, c.index, true
+	case !ok:
+		if _, _, found := ti.searchExt(name); !found {
+			return 0, 0, false
+		}
+	}
+	x.build(ti)
+	c, ok = x.m[name]
+	return c.kind, c.index, ok
+}
+
+// searchExt searches the extended capability names for name.
+func (ti *Terminfo) searchExt(name string) (CapKind, int, bool) {
+	for _, kind := range []CapKind{CapKindExtBool, CapKindExtNum, CapKindExtString} {
+		names := ti.extNames(kind)
+		for i := 0; i < len(names); i++ {
+			if n, ok := names[i]; ok && string(n) == name {
+				return kind, i, true
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// ExtCaps returns all extended capabilities in file order (bools, nums, and
+// then strings, each in index order).
+func (ti *Terminfo) ExtCaps() []ExtCap {
+	var caps []ExtCap
+	for _, kind := range []CapKind{CapKindExtBool, CapKindExtNum, CapKindExtString} {
+		names := ti.extNames(kind)
+		for i := 0; i < len(names); i++ {
+			if n, ok := names[i]; ok {
+				caps = append(caps, ExtCap{string(n), kind, i})
+			}
+		}
+	}
+	return caps
+}
+
+// ExtBool returns the extended bool cap name.
+func (ti *Terminfo) ExtBool(name string) bool {
+	kind, i, ok := ti.lookupExt(name)
+	return ok && kind == CapKindExtBool && ti.ExtBools[i]
+}
+
+// ExtNum returns the extended num cap name, or -1 if not present.
+func (ti *Terminfo) ExtNum(name string) int {
+	kind, i, ok := ti.lookupExt(name)
+	if !ok || kind != CapKindExtNum {
+		return -1
+	}
+	if n, ok := ti.ExtNums[i]; ok && n >= 0 {
+		return n
+	}
+	return -1
+}
+
+// ExtString returns the extended string cap name, or nil if not present.
+func (ti *Terminfo) ExtString(name string) []byte {
+	kind, i, ok := ti.lookupExt(name)
+	if !ok || kind != CapKindExtString {
+		return nil
+	}
+	return ti.ExtStrings[i]
+}
+
+// ExtPrintf formats the extended string cap name, interpolating parameters v.
+func (ti *Terminfo) ExtPrintf(name string, v ...interface{}) string {
+	return ti.StaticVars().Printf(ti.ExtString(name), v...)
+}
+
+// ExtFprintf prints the extended string cap name to writer w, interpolating
+// parameters v.
+func (ti *Terminfo) ExtFprintf(w io.Writer, name string, v ...interface{}) {
+	ti.StaticVars().Fprintf(w, ti.ExtString(name), v...)
+}
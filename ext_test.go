@@ -0,0 +1,108 @@
+package terminfo
+
+import (
+	"bytes"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestExtCaps(t *testing.T) {
+	ti := &Terminfo{
+		ExtBools:       map[int]bool{0: true, 1: false},
+		ExtBoolNames:   map[int][]byte{0: []byte("Tc"), 1: []byte("AX")},
+		ExtNums:        map[int]int{0: 1, 1: -1},
+		ExtNumNames:    map[int][]byte{0: []byte("U8"), 1: []byte("XX")},
+		ExtStrings:     map[int][]byte{0: []byte("\x1b[4:%p1%dm")},
+		ExtStringNames: map[int][]byte{0: []byte("Smulx")},
+	}
+	for _, index := range []bool{false, true} {
+		if index {
+			ti.indexExt()
+		}
+		exp := []ExtCap{
+			{"Tc", CapKindExtBool, 0},
+			{"AX", CapKindExtBool, 1},
+			{"U8", CapKindExtNum, 0},
+			{"XX", CapKindExtNum, 1},
+			{"Smulx", CapKindExtString, 0},
+		}
+		if caps := ti.ExtCaps(); !reflect.DeepEqual(caps, exp) {
+			t.Errorf("expected %v, got: %v", exp, caps)
+		}
+		if !ti.ExtBool("Tc") || ti.ExtBool("AX") || ti.ExtBool("Smulx") {
+			t.Errorf("expected only Tc to be true")
+		}
+		if n := ti.ExtNum("U8"); n != 1 {
+			t.Errorf("expected U8 to be 1, got: %d", n)
+		}
+		if n := ti.ExtNum("XX"); n != -1 {
+			t.Errorf("expected XX to be -1, got: %d", n)
+		}
+		if s := ti.ExtPrintf("Smulx", 3); s != "\x1b[4:3m" {
+			t.Errorf("expected %q, got: %q", "\x1b[4:3m", s)
+		}
+		buf := new(bytes.Buffer)
+		if ti.ExtFprintf(buf, "missing"); buf.Len() != 0 {
+			t.Errorf("expected no output for missing cap, got: %q", buf.String())
+		}
+	}
+}
+
+func TestExtCapsDecode(t *testing.T) {
+	for term, file := range terms(t) {
+		ti, err := Open(filepath.Dir(filepath.Dir(file)), term)
+		if err != nil {
+			t.Fatalf("term %s expected no error, got: %v", term, err)
+		}
+		for _, c := range ti.ExtCaps() {
+			kind, i, ok := ti.LookupCap(c.Name)
+			if !ok || kind != c.Kind || i != c.Index {
+				t.Errorf("term %s extended cap %s should lookup to %s %d, got: %s %d %t", term, c.Name, c.Kind, c.Index, kind, i, ok)
+			}
+			switch c.Kind {
+			case CapKindExtBool:
+				if ti.ExtBool(c.Name) != ti.ExtBools[c.Index] {
+					t.Errorf("term %s extended bool %s does not match", term, c.Name)
+				}
+			case CapKindExtString:
+				if !bytes.Equal(ti.ExtString(c.Name), ti.ExtStrings[c.Index]) {
+					t.Errorf("term %s extended string %s does not match", term, c.Name)
+				}
+			}
+		}
+	}
+}
+
+func TestExtCapsEdit(t *testing.T) {
+	ti := openTerm(t, "xterm-256color")
+	if !ti.ExtBool("XT") || ti.ExtBool("Zz") {
+		t.Fatalf("expected XT and not Zz")
+	}
+	_, i, _ := ti.LookupCap("XT")
+
+	// renaming a name, looking up the new name first
+	ti.ExtBoolNames[i] = []byte("Zz")
+	if !ti.ExtBool("Zz") || ti.ExtBool("XT") {
+		t.Errorf("expected renamed XT to be Zz")
+	}
+
+	// renaming a name, looking up the old name first
+	ti.ExtBoolNames[i] = []byte("XT")
+	if !ti.ExtBool("XT") || ti.ExtBool("Zz") {
+		t.Errorf("expected renamed Zz to be XT")
+	}
+
+	// adding a name
+	n := len(ti.ExtBoolNames)
+	ti.ExtBools[n], ti.ExtBoolNames[n] = true, []byte("Yy")
+	if !ti.ExtBool("Yy") {
+		t.Errorf("expected added Yy")
+	}
+
+	// removing a name
+	delete(ti.ExtBoolNames, n)
+	if ti.ExtBool("Yy") {
+		t.Errorf("expected removed Yy to be missing")
+	}
+}
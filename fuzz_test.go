@@ -0,0 +1,66 @@
+//go:build go1.18
+// +build go1.18
+
+package terminfo
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+// fuzzEntries returns the system database entries used to seed the fuzz
+// targets.
+func fuzzEntries(f *testing.F) [][]byte {
+	seen := make(map[string]bool)
+	var bufs [][]byte
+	for _, file := range terms(f) {
+		buf, err := ioutil.ReadFile(file)
+		if err != nil {
+			f.Fatal(err)
+		}
+		if !seen[string(buf)] {
+			seen[string(buf)], bufs = true, append(bufs, buf)
+		}
+	}
+	return append(bufs, stateEntry, sizedEntry(magic, 5000, 100), sizedEntry(magicExtended, 5000, 100))
+}
+
+func FuzzDecode(f *testing.F) {
+	for _, buf := range fuzzEntries(f) {
+		f.Add(buf)
+	}
+	f.Fuzz(func(t *testing.T, buf []byte) {
+		ti, err := Decode(buf)
+		if err != nil {
+			return
+		}
+		for _, i := range ti.StringsP.Indexes() {
+			_ = ti.Printf(i, 1, 2)
+		}
+		for _, name := range ti.ExtStringNames {
+			_ = ti.CapState(string(name))
+		}
+		_ = Diff(ti, ti.Clone())
+	})
+}
+
+func FuzzPrintf(f *testing.F) {
+	seen := make(map[string]bool)
+	for _, buf := range fuzzEntries(f) {
+		ti, err := Decode(buf)
+		if err != nil {
+			continue
+		}
+		for _, s := range ti.Strings {
+			if s != nil && !seen[string(s)] {
+				seen[string(s)] = true
+				f.Add(s, 1, 2, "a")
+			}
+		}
+	}
+	f.Fuzz(func(t *testing.T, z []byte, a, b int, s string) {
+		_ = Printf(z, a, b, s)
+		_ = Printf(z, s, b)
+		_ = Disassemble(z)
+	})
+}
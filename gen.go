@@ -1,3 +1,4 @@
+//go:build ignore
 // +build ignore
 
 package main
@@ -20,8 +21,6 @@ import (
 	"regexp"
 	"strings"
 	"unicode"
-
-	"github.com/kenshaw/snaker"
 )
 
 const (
@@ -38,13 +37,14 @@ func notSpace(r rune) bool {
 func main() {
 	cache := flag.String("cache", ".cache", "cache directory")
 	out := flag.String("out", "capvals.go", "out file")
+	info := flag.String("info", "capinfo.go", "info out file")
 	flag.Parse()
-	if err := run(*cache, *out); err != nil {
+	if err := run(*cache, *out, *info); err != nil {
 		log.Fatal(err)
 	}
 }
 
-func run(cache, dest string) error {
+func run(cache, dest, info string) error {
 	// get archive
 	buf, err := get(cache, ncursesSrc)
 	if err != nil {
@@ -56,16 +56,24 @@ func run(cache, dest string) error {
 		return err
 	}
 	// process caps
-	buf, err = processCaps(caps)
+	buf, infoBuf, err := processCaps(caps)
 	if err != nil {
 		return err
 	}
 	// write
-	buf, err = format.Source(buf)
+	if err := write(dest, buf); err != nil {
+		return err
+	}
+	return write(info, infoBuf)
+}
+
+// write formats and writes buf to the file.
+func write(file string, buf []byte) error {
+	buf, err := format.Source(buf)
 	if err != nil {
 		return err
 	}
-	return ioutil.WriteFile(dest, buf, 0o644)
+	return ioutil.WriteFile(file, buf, 0o644)
 }
 
 // get retrieves a file either from the the http path, or from disk.
@@ -137,8 +145,9 @@ func load(buf []byte, file string) ([]byte, error) {
 	return nil, fmt.Errorf("could not load file %s", file)
 }
 
-// processCaps processes the data in the Caps file.
-func processCaps(capsBuf []byte) ([]byte, error) {
+// processCaps processes the data in the Caps file, returning the cap values
+// and cap info sources.
+func processCaps(capsBuf []byte) ([]byte, []byte, error) {
 	// create scanner
 	s := bufio.NewScanner(bytes.NewReader(capsBuf))
 	s.Buffer(make([]byte, 1024*1024), 1024*1024)
@@ -147,12 +156,30 @@ func processCaps(capsBuf []byte) ([]byte, error) {
 	var boolCount, numCount, stringCount int
 	var lastBool, lastNum, lastString string
 	var boolNames, numNames, stringNames []string
+	var boolTermcaps, numTermcaps, stringTermcaps []string
+	// lookup tables
+	lookup, termcapLookup := new(bytes.Buffer), new(bytes.Buffer)
+	seen, seenTermcap := make(map[string]bool), make(map[string]bool)
+	// info tables
+	boolInfos, numInfos, stringInfos := new(bytes.Buffer), new(bytes.Buffer), new(bytes.Buffer)
+	obsolete := make(map[string]bool)
+	var aliases [][]string
+	seenAlias := make(map[string]bool)
+	shortNames, termcapNames := make(map[string]string), make(map[string]string)
 	// process caps
 	var n int
 	for s.Scan() {
 		// read line
 		line := strings.TrimSpace(commentRE.ReplaceAllString(strings.Trim(s.Text(), "\x00"), ""))
-		if len(line) == 0 || strings.HasPrefix(line, "capalias") || strings.HasPrefix(line, "infoalias") {
+		if len(line) == 0 {
+			continue
+		}
+		// save aliases for processing after all caps have been read, skipping
+		// repeated aliases (the Caps file lists the XENIX Gr alias twice)
+		if strings.HasPrefix(line, "capalias") || strings.HasPrefix(line, "infoalias") {
+			if row := strings.Fields(line); len(row) >= 4 && !seenAlias[row[0]+" "+row[1]] {
+				aliases, seenAlias[row[0]+" "+row[1]] = append(aliases, row), true
+			}
 			continue
 		}
 		// split line's columns
@@ -165,32 +192,32 @@ func processCaps(capsBuf []byte) ([]byte, error) {
 		}
 		row[7] = strings.TrimSpace(line)
 		// manipulation
-		var buf *bytes.Buffer
-		var names *[]string
-		var typ, isFirst, prefix, suffix string
+		var buf, infos *bytes.Buffer
+		var names, termcaps *[]string
+		var typ, kind, isFirst, prefix, suffix string
 		// format variable name
-		name := snaker.SnakeToCamel(row[0])
+		name := snakeToCamel(row[0])
 		switch row[2] {
 		case "bool":
 			if boolCount == 0 {
 				isFirst = " = iota"
 			}
-			buf, names, lastBool, prefix, suffix = bools, &boolNames, name, "indicates", ""
-			typ = "bool"
+			buf, infos, names, termcaps, lastBool, prefix, suffix = bools, boolInfos, &boolNames, &boolTermcaps, name, "indicates", ""
+			typ, kind = "bool", "CapKindBool"
 			boolCount++
 		case "num":
 			if numCount == 0 {
 				isFirst = " = iota"
 			}
-			buf, names, lastNum, prefix, suffix = nums, &numNames, name, "is", ""
-			typ = "num"
+			buf, infos, names, termcaps, lastNum, prefix, suffix = nums, numInfos, &numNames, &numTermcaps, name, "is", ""
+			typ, kind = "num", "CapKindNum"
 			numCount++
 		case "str":
 			if stringCount == 0 {
 				isFirst = " = iota"
 			}
-			buf, names, lastString, prefix, suffix = strs, &stringNames, name, "is the", ""
-			typ = "string"
+			buf, infos, names, termcaps, lastString, prefix, suffix = strs, stringInfos, &stringNames, &stringTermcaps, name, "is the", ""
+			typ, kind = "string", "CapKindString"
 			stringCount++
 		default:
 			log.Fatal("line %d is invalid, has type: %s", n, row[2])
@@ -200,10 +227,42 @@ func processCaps(capsBuf []byte) ([]byte, error) {
 		}
 		buf.WriteString(fmt.Sprintf("// The %s [%s, %s] %s capability ", name, row[0], row[1], typ) + formatComment(row[7], prefix, suffix) + "\n" + name + isFirst + "\n")
 		*names = append(*names, row[0], row[1])
+		*termcaps = append(*termcaps, row[3])
+		// add lookups, the first definition of a name wins
+		for _, k := range []string{row[0], row[1]} {
+			if !seen[k] {
+				lookup.WriteString(fmt.Sprintf("%q: {%s, %s},\n", k, kind, name))
+				seen[k] = true
+			}
+		}
+		if !seenTermcap[row[3]] {
+			termcapLookup.WriteString(fmt.Sprintf("%q: {%s, %s},\n", row[3], kind, name))
+			seenTermcap[row[3]] = true
+		}
+		shortNames[row[1]] = row[0]
+		if _, ok := termcapNames[row[3]]; !ok {
+			termcapNames[row[3]] = row[0]
+		}
+		// determine category, caps following the obsolete termcap caps are
+		// ncurses extensions
+		category := "CapCategoryStandard"
+		switch {
+		case row[4] != "-":
+			category = "CapCategoryKey"
+		case strings.HasPrefix(row[1], "OT"):
+			category, obsolete[typ] = "CapCategoryObsolete", true
+		case obsolete[typ]:
+			category = "CapCategoryExtension"
+		}
+		var keyName string
+		if row[4] != "-" {
+			keyName = row[4]
+		}
+		infos.WriteString(fmt.Sprintf("{%q, %q, %q, %s, %s, %q, %s, %q},\n", row[0], row[1], row[3], kind, name, row[7], category, keyName))
 		n++
 	}
 	if err := s.Err(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	f := new(bytes.Buffer)
 	f.WriteString(hdr)
@@ -231,7 +290,57 @@ func processCaps(capsBuf []byte) ([]byte, error) {
 		}
 		f.WriteString("}\n")
 	}
-	return f.Bytes(), nil
+	// add termcap names
+	for n, s := range [][]string{boolTermcaps, numTermcaps, stringTermcaps} {
+		y, names := z[n], [][]string{boolNames, numNames, stringNames}[n]
+		f.WriteString(fmt.Sprintf("// %sCapTermcapNames are the %s termcap names.\n", y, y))
+		f.WriteString(fmt.Sprintf("var %sCapTermcapNames = [...]string{\n", y))
+		for i := 0; i < len(s); i++ {
+			f.WriteString(fmt.Sprintf("%q, // %s\n", s[i], names[2*i]))
+		}
+		f.WriteString("}\n")
+	}
+	// add lookups
+	f.WriteString("// capNames are the long and short term cap names mapped to their kind and index.\n")
+	f.WriteString("var capNames = map[string]capIndex{\n")
+	lookup.WriteTo(f)
+	f.WriteString("}\n")
+	f.WriteString("// termcapNames are the termcap names mapped to their kind and index.\n")
+	f.WriteString("var termcapNames = map[string]capIndex{\n")
+	termcapLookup.WriteTo(f)
+	f.WriteString("}\n")
+	// add infos
+	i := new(bytes.Buffer)
+	i.WriteString(hdr)
+	i.WriteString("// capInfos are the bool, num and string capability descriptions.\n")
+	i.WriteString("var capInfos = [...]CapInfo{\n")
+	for _, b := range []*bytes.Buffer{boolInfos, numInfos, stringInfos} {
+		b.WriteTo(i)
+	}
+	i.WriteString("}\n\n")
+	// add aliases, resolving the aliased cap to its long name
+	i.WriteString("// capAliases are the termcap and terminfo capability aliases.\n")
+	i.WriteString("var capAliases = [...]CapAlias{\n")
+	for _, row := range aliases {
+		termcap, names := row[0] == "capalias", shortNames
+		if termcap {
+			names = termcapNames
+		}
+		i.WriteString(fmt.Sprintf("{%q, %q, %q, %t},\n", row[1], names[row[2]], row[3], termcap))
+	}
+	i.WriteString("}\n")
+	return f.Bytes(), i.Bytes(), nil
+}
+
+// snakeToCamel converts the snake case name s to camel case.
+func snakeToCamel(s string) string {
+	var b strings.Builder
+	for _, w := range strings.Split(s, "_") {
+		if w != "" {
+			b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+		}
+	}
+	return b.String()
 }
 
 // formatComment formats comments with prefix and suffix.
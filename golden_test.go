@@ -0,0 +1,246 @@
+package terminfo
+
+import (
+	"bytes"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var (
+	update        = flag.Bool("update", false, "update the golden files in testdata")
+	tparm         = flag.String("tparm", "", "regenerate the Printf golden files with the tparm harness built from testdata/printf/tparm.c")
+	updateInfocmp = flag.Bool("infocmp", false, "regenerate the infocmp output in testdata with ncurses' infocmp")
+)
+
+// printfParams are the numbers and strings passed as params when generating
+// the Printf golden files. As with ncurses, a param is passed as a string
+// when the format uses it as a string.
+var printfParams = [][2]string{
+	{"1 2 3 4 5 6 7 8 9", "a b c d e f g h i"},
+	{"256 0 1 0 1 0 1 0 1", "a b c d e f g h i"},
+	{"-1 -2 -3 -4 -5 -6 -7 -8 -9", "z y x w v u t s r"},
+}
+
+func TestDecodeGolden(t *testing.T) {
+	for _, term := range sortedTerms(t) {
+		ti := openTerm(t, term)
+		golden(t, filepath.Join("testdata", "decode", term+".golden"), dumpTerminfo(ti))
+	}
+}
+
+func TestPrintfGolden(t *testing.T) {
+	for _, term := range sortedTerms(t) {
+		file := filepath.Join("testdata", "printf", term+".golden")
+		if *tparm != "" {
+			if err := ioutil.WriteFile(file, tparmPrintf(t, openTerm(t, term)), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		buf, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatalf("could not read golden file (run with -tparm to create it), got: %v", err)
+		}
+		checkPrintf(t, file, buf)
+	}
+}
+
+// sortedTerms returns the names of the corpus entries, sorted.
+func sortedTerms(t *testing.T) []string {
+	var names []string
+	for term := range terms(t) {
+		names = append(names, term)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// openTerm opens the corpus entry term.
+func openTerm(t *testing.T, term string) *Terminfo {
+	ti, err := Open(termDirs()[0], term)
+	if err != nil {
+		t.Fatalf("term %s expected no error, got: %v", term, err)
+	}
+	return ti
+}
+
+// golden compares buf to the golden file, or writes buf to the file when
+// -update is passed.
+func golden(t *testing.T, file string, buf []byte) {
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(file, buf, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	exp, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatalf("could not read golden file (run with -update to create it), got: %v", err)
+	}
+	if !bytes.Equal(buf, exp) {
+		a, b := strings.Split(string(exp), "\n"), strings.Split(string(buf), "\n")
+		for i := 0; i < len(a) || i < len(b); i++ {
+			var x, y string
+			if i < len(a) {
+				x = a[i]
+			}
+			if i < len(b) {
+				y = b[i]
+			}
+			if x != y {
+				t.Errorf("%s line %d does not match:\nwant: %s\ngot:  %s", file, i+1, x, y)
+				return
+			}
+		}
+	}
+}
+
+// dumpTerminfo returns a text dump of the decoded terminfo, with a line for
+// each present or cancelled cap, in index order.
+func dumpTerminfo(ti *Terminfo) []byte {
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "names\t%q\n", ti.Names)
+	for i := 0; i < CapCountBool; i++ {
+		dumpCap(buf, "bool", BoolCapNameShort(i), ti.State(CapKindBool, i), "")
+	}
+	for i := 0; i < CapCountNum; i++ {
+		dumpCap(buf, "num", NumCapNameShort(i), ti.State(CapKindNum, i), fmt.Sprintf("#%d", ti.Nums[i]))
+	}
+	for i := 0; i < CapCountString; i++ {
+		dumpCap(buf, "string", StringCapNameShort(i), ti.State(CapKindString, i), fmt.Sprintf("=%q", ti.Strings[i]))
+	}
+	for i := 0; i < len(ti.ExtBoolNames); i++ {
+		dumpCap(buf, "ext bool", string(ti.ExtBoolNames[i]), ti.State(CapKindExtBool, i), "")
+	}
+	for i := 0; i < len(ti.ExtNumNames); i++ {
+		dumpCap(buf, "ext num", string(ti.ExtNumNames[i]), ti.State(CapKindExtNum, i), fmt.Sprintf("#%d", ti.ExtNums[i]))
+	}
+	for i := 0; i < len(ti.ExtStringNames); i++ {
+		dumpCap(buf, "ext string", string(ti.ExtStringNames[i]), ti.State(CapKindExtString, i), fmt.Sprintf("=%q", ti.ExtStrings[i]))
+	}
+	return buf.Bytes()
+}
+
+// dumpCap writes a line for the cap name to buf, with its value when present.
+func dumpCap(buf *bytes.Buffer, kind, name string, state CapState, value string) {
+	switch state {
+	case CapPresent:
+		fmt.Fprintf(buf, "%s\t%s%s\n", kind, name, value)
+	case CapCancelled:
+		fmt.Fprintf(buf, "%s\t%s@\n", kind, name)
+	}
+}
+
+// tparmPrintf returns the output of the tparm harness for each of the
+// terminfo's parameterized strings, with each of the printfParams, as the
+// params passed to ncurses' tiparm and its result. Results that are the same
+// for more than one of the printfParams, and formats ncurses rejects, are
+// omitted.
+func tparmPrintf(t *testing.T, ti *Terminfo) []byte {
+	type format struct {
+		name string
+		z    []byte
+	}
+	var formats []format
+	add := func(name string, z []byte) {
+		if bytes.IndexByte(z, '%') != -1 {
+			formats = append(formats, format{name, z})
+		}
+	}
+	for i := 0; i < CapCountString; i++ {
+		add(StringCapNameShort(i), ti.Strings[i])
+	}
+	for i := 0; i < len(ti.ExtStringNames); i++ {
+		add(string(ti.ExtStringNames[i]), ti.ExtStrings[i])
+	}
+
+	// run the harness
+	in := new(bytes.Buffer)
+	for _, f := range formats {
+		for _, params := range printfParams {
+			fmt.Fprintf(in, "%x\t%s\t%s\n", f.z, params[0], params[1])
+		}
+	}
+	cmd := exec.Command(*tparm)
+	cmd.Stdin, cmd.Stderr = in, os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("could not run %s, got: %v", *tparm, err)
+	}
+	lines := strings.Split(string(out), "\n")
+	if len(lines) != len(formats)*len(printfParams)+1 {
+		t.Fatalf("expected %d lines from %s, got: %d", len(formats)*len(printfParams), *tparm, len(lines)-1)
+	}
+
+	buf := new(bytes.Buffer)
+	for i, f := range formats {
+		fmt.Fprintf(buf, "%s\t%q\n", f.name, f.z)
+		seen := make(map[string]bool)
+		for _, line := range lines[i*len(printfParams) : (i+1)*len(printfParams)] {
+			if line == "NULL" || seen[line] {
+				continue
+			}
+			seen[line] = true
+			j := strings.LastIndexByte(line, '\t')
+			res, err := hex.DecodeString(line[j+1:])
+			if err != nil {
+				t.Fatalf("could not decode %s output %q, got: %v", *tparm, line, err)
+			}
+			fmt.Fprintf(buf, "\t%s\t%q\n", line[:j], res)
+		}
+	}
+	return buf.Bytes()
+}
+
+// checkPrintf checks the result of Printf for each of the params and results
+// in the Printf golden file.
+func checkPrintf(t *testing.T, file string, buf []byte) {
+	var name string
+	var z []byte
+	for i, line := range strings.Split(string(buf), "\n") {
+		v := strings.Split(line, "\t")
+		switch {
+		case line == "" && i == strings.Count(string(buf), "\n"):
+			// end of file
+		case len(v) == 2 && v[0] != "":
+			s, err := strconv.Unquote(v[1])
+			if err != nil {
+				t.Fatalf("%s line %d could not unquote format, got: %v", file, i+1, err)
+			}
+			name, z = v[0], []byte(s)
+		case len(v) == 3 && v[0] == "" && z != nil:
+			exp, err := strconv.Unquote(v[2])
+			if err != nil {
+				t.Fatalf("%s line %d could not unquote result, got: %v", file, i+1, err)
+			}
+			var params []interface{}
+			for _, p := range strings.Fields(v[1]) {
+				if s, err := strconv.Unquote(p); err == nil {
+					params = append(params, s)
+				} else if n, err := strconv.Atoi(p); err == nil {
+					params = append(params, n)
+				} else {
+					t.Fatalf("%s line %d has invalid param %q", file, i+1, p)
+				}
+			}
+			// static variables persist between calls, so use new ones as the
+			// harness resets them before each call
+			if s := new(StaticVars).Printf(z, params...); s != exp {
+				t.Errorf("%s line %d %s %q with %v expected %q, got: %q", file, i+1, name, z, params, exp, s)
+			}
+		default:
+			t.Fatalf("%s line %d is invalid", file, i+1)
+		}
+	}
+}
@@ -1,68 +1,162 @@
 package terminfo
 
 import (
+	"fmt"
 	"os"
 	"os/user"
 	"path"
 	"strings"
-	"sync"
 )
 
-// termCache is the terminfo cache.
-var termCache = struct {
-	db map[string]*Terminfo
-	sync.RWMutex
-}{
-	db: make(map[string]*Terminfo),
+// LoadAttempt is a file tried while loading a terminfo, and the reason it
+// could not be used.
+type LoadAttempt struct {
+	// Dir is the database directory.
+	Dir string
+
+	// File is the path to the file.
+	File string
+
+	// Err is the reason the file could not be used: an error satisfying
+	// os.IsNotExist when the file is missing, os.IsPermission when it cannot
+	// be read, or the decode error when the file is corrupt.
+	Err error
+}
+
+// LoadError is the error returned by Load and Open when a terminfo cannot be
+// loaded, describing the locations searched.
+type LoadError struct {
+	// Name is the term name.
+	Name string
+
+	// Dirs are the database directories searched, in order.
+	Dirs []string
+
+	// Attempts are the files tried, in order.
+	Attempts []LoadAttempt
+
+	// Err is the underlying error: ErrDatabaseDirectoryNotFound when none of
+	// the directories exist, ErrFileNotFound when no file for the name was
+	// found, or the decode error of a corrupt file.
+	Err error
+}
+
+// Error satisfies the error interface.
+func (err *LoadError) Error() string {
+	return fmt.Sprintf("could not load %q (searched %s): %v", err.Name, strings.Join(err.Dirs, ", "), err.Err)
+}
+
+// Unwrap returns the underlying error.
+func (err *LoadError) Unwrap() error {
+	return err.Err
 }
 
 // Load follows the behavior described in terminfo(5) to find correct the
 // terminfo file using the name, reads the file and then returns a Terminfo
 // struct that describes the file.
+//
+// When the terminfo cannot be loaded, the returned error is a *LoadError,
+// which can be checked against ErrDatabaseDirectoryNotFound, ErrFileNotFound
+// or the decode error using errors.Is.
 func Load(name string) (*Terminfo, error) {
 	if name == "" {
 		return nil, ErrEmptyTermName
 	}
 
-	termCache.RLock()
-	ti, ok := termCache.db[name]
-	termCache.RUnlock()
-
+	// cache by the search path, so that changing the environment does not
+	// return a terminfo from other directories
+	dirs := SearchPath()
+	key := strings.Join(dirs, ":")
+	ti, ok := termCache.get(key, name)
 	if ok {
 		return ti, nil
 	}
 
-	var checkDirs []string
+	lerr := &LoadError{Name: name, Err: ErrDatabaseDirectoryNotFound}
+	for _, dir := range dirs {
+		lerr.Dirs = append(lerr.Dirs, dir)
+		ti, modTime, attempts, err := open(dir, name)
+		lerr.Attempts = append(lerr.Attempts, attempts...)
+		switch {
+		case err == nil:
+			termCache.put(key, ti, modTime)
+			return ti, nil
+		case err != ErrFileNotFound:
+			lerr.Err = err
+			return nil, lerr
+		}
+		if fi, err := os.Stat(dir); err == nil && fi.IsDir() {
+			lerr.Err = ErrFileNotFound
+		}
+	}
+
+	return nil, lerr
+}
+
+// defaultDirs are the default terminfo directories.
+var defaultDirs = []string{"/etc/terminfo", "/lib/terminfo", "/usr/share/terminfo"}
+
+// SearchPath returns the directories searched by Load, in order, following
+// the behavior described in terminfo(5):
+//
+//  1. $TERMINFO
+//  2. $HOME/.terminfo (using the current user's home directory when $HOME is
+//     not set)
+//  3. each directory in $TERMINFO_DIRS, where an empty component is replaced
+//     with the default directories
+//  4. the default directories (/etc/terminfo, /lib/terminfo and
+//     /usr/share/terminfo)
+//
+// Each directory is only returned once.
+func SearchPath() []string {
+	var dirs []string
 
 	// check $TERMINFO
 	if dir := os.Getenv("TERMINFO"); dir != "" {
-		checkDirs = append(checkDirs, dir)
+		dirs = append(dirs, dir)
 	}
 
 	// check $HOME/.terminfo
-	u, err := user.Current()
-	if err != nil {
-		return nil, err
+	if home := homeDir(); home != "" {
+		dirs = append(dirs, path.Join(home, ".terminfo"))
 	}
-	checkDirs = append(checkDirs, path.Join(u.HomeDir, ".terminfo"))
 
 	// check $TERMINFO_DIRS
-	if dirs := os.Getenv("TERMINFO_DIRS"); dirs != "" {
-		checkDirs = append(checkDirs, strings.Split(dirs, ":")...)
+	if v := os.Getenv("TERMINFO_DIRS"); v != "" {
+		for _, dir := range strings.Split(v, ":") {
+			if dir == "" {
+				dirs = append(dirs, defaultDirs...)
+			} else {
+				dirs = append(dirs, dir)
+			}
+		}
 	}
 
-	// check fallback directories
-	checkDirs = append(checkDirs, "/etc/terminfo", "/lib/terminfo", "/usr/share/terminfo")
-	for _, dir := range checkDirs {
-		ti, err = Open(dir, name)
-		if err != nil && err != ErrFileNotFound && !os.IsNotExist(err) {
-			return nil, err
-		} else if err == nil {
-			return ti, nil
+	// check default directories
+	dirs = append(dirs, defaultDirs...)
+
+	// remove duplicates
+	seen := make(map[string]bool, len(dirs))
+	var z []string
+	for _, dir := range dirs {
+		if !seen[dir] {
+			seen[dir], z = true, append(z, dir)
 		}
 	}
 
-	return nil, ErrDatabaseDirectoryNotFound
+	return z
+}
+
+// homeDir returns $HOME, or the current user's home directory when $HOME is
+// not set.
+func homeDir() string {
+	if home := os.Getenv("HOME"); home != "" {
+		return home
+	}
+	if u, err := user.Current(); err == nil {
+		return u.HomeDir
+	}
+	return ""
 }
 
 // LoadFromEnv loads the terminal info based on the name contained in
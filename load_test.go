@@ -1,12 +1,18 @@
 package terminfo
 
 import (
+	"errors"
+	"io/ioutil"
 	"os"
-	"runtime"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 )
 
 func TestLoad(t *testing.T) {
+	defer setenv("TERMINFO", termDirs()[0], "TERM", "")()
+	defer ClearCache()
 	for term, file := range terms(t) {
 		err := os.Setenv("TERM", term)
 		if err != nil {
@@ -20,7 +26,7 @@ func TestLoad(t *testing.T) {
 		}
 
 		// check the name was saved correctly
-		if runtime.GOOS != "darwin" && ti.File != file {
+		if ti.File != file {
 			t.Errorf("term %s should have file %s, got: %s", term, file, ti.File)
 		}
 
@@ -30,3 +36,175 @@ func TestLoad(t *testing.T) {
 		}
 	}
 }
+
+// setenv sets the environment variables in kv (pairs of keys and values),
+// returning a func that restores their previous values.
+func setenv(kv ...string) func() {
+	var restore []func()
+	for i := 0; i < len(kv); i += 2 {
+		k := kv[i]
+		if v, ok := os.LookupEnv(k); ok {
+			restore = append(restore, func() { os.Setenv(k, v) })
+		} else {
+			restore = append(restore, func() { os.Unsetenv(k) })
+		}
+		if kv[i+1] == "" {
+			os.Unsetenv(k)
+		} else {
+			os.Setenv(k, kv[i+1])
+		}
+	}
+	return func() {
+		for _, f := range restore {
+			f()
+		}
+	}
+}
+
+func TestSearchPath(t *testing.T) {
+	defaults := []string{"/etc/terminfo", "/lib/terminfo", "/usr/share/terminfo"}
+	tests := []struct {
+		terminfo, home, dirs string
+		exp                  []string
+	}{
+		{"", "/h", "", append([]string{"/h/.terminfo"}, defaults...)},
+		{"/t", "/h", "", append([]string{"/t", "/h/.terminfo"}, defaults...)},
+		{"/t", "/h", "/a:/b", append([]string{"/t", "/h/.terminfo", "/a", "/b"}, defaults...)},
+		{"", "/h", "/a::/b", append(append([]string{"/h/.terminfo", "/a"}, defaults...), "/b")},
+		{"", "/h", ":/a", append(append([]string{"/h/.terminfo"}, defaults...), "/a")},
+		{"/lib/terminfo", "/h", "/h/.terminfo", append([]string{"/lib/terminfo", "/h/.terminfo", "/etc/terminfo"}, "/usr/share/terminfo")},
+	}
+	for i, test := range tests {
+		restore := setenv("TERMINFO", test.terminfo, "HOME", test.home, "TERMINFO_DIRS", test.dirs)
+		dirs := SearchPath()
+		restore()
+		if !reflect.DeepEqual(dirs, test.exp) {
+			t.Errorf("test %d expected %v, got: %v", i, test.exp, dirs)
+		}
+	}
+}
+
+func TestLoadSearchPath(t *testing.T) {
+	root, err := ioutil.TempDir("", "terminfo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	// fixture directories, with the zz-a entry in all of them, and each other
+	// entry in a single directory
+	dir := func(name string) string { return filepath.Join(root, name) }
+	writeEntries(t, dir("terminfo"), stateEntry, "z/zz-a", "z/zz-terminfo")
+	writeEntries(t, dir("home/.terminfo"), stateEntry, "z/zz-a", "z/zz-home")
+	writeEntries(t, dir("dirs1"), stateEntry, "z/zz-a", "7a/zz-dirs1")
+	writeEntries(t, dir("dirs2"), stateEntry, "z/zz-a", "z/zz-dirs2")
+
+	defer setenv("HOME", dir("home"), "TERMINFO_DIRS", dir("dirs1")+"::"+dir("dirs2"))()
+	defer ClearCache()
+	tests := []struct {
+		terminfo, name, exp string
+	}{
+		{dir("terminfo"), "zz-a", "terminfo/z/zz-a"},
+		{dir("terminfo"), "zz-terminfo", "terminfo/z/zz-terminfo"},
+		{dir("terminfo"), "zz-home", "home/.terminfo/z/zz-home"},
+		{dir("terminfo"), "zz-dirs1", "dirs1/7a/zz-dirs1"},
+		{dir("terminfo"), "zz-dirs2", "dirs2/z/zz-dirs2"},
+		{"", "zz-a", "home/.terminfo/z/zz-a"},
+		{"", "zz-terminfo", ""},
+	}
+	for i, test := range tests {
+		ClearCache()
+		restore := setenv("TERMINFO", test.terminfo)
+		ti, err := Load(test.name)
+		restore()
+		switch {
+		case test.exp == "" && !errors.Is(err, ErrFileNotFound):
+			t.Errorf("test %d expected ErrFileNotFound, got: %v", i, err)
+		case test.exp == "":
+		case err != nil:
+			t.Errorf("test %d expected no error, got: %v", i, err)
+		case ti.File != dir(test.exp):
+			t.Errorf("test %d expected file %s, got: %s", i, dir(test.exp), ti.File)
+		}
+	}
+}
+
+func TestLoadError(t *testing.T) {
+	root, err := ioutil.TempDir("", "terminfo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	dir := func(name string) string { return filepath.Join(root, name) }
+	writeEntries(t, dir("a"), stateEntry, "z/zz-a")
+	writeEntries(t, dir("b"), stateEntry, "z/zz-c")
+	if err := os.Chmod(dir("b/z/zz-c"), 0); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 100)
+	copy(buf, "corrupt")
+	writeEntries(t, dir("b"), buf, "z/zz-a", "z/zz-b")
+
+	defer setenv("TERMINFO", "", "HOME", dir("home"), "TERMINFO_DIRS", dir("a")+":"+dir("b"))()
+	defer func(dirs []string) { defaultDirs = dirs }(defaultDirs)
+	defaultDirs = []string{dir("missing")}
+	defer ClearCache()
+
+	tests := []struct {
+		name  string
+		exp   error
+		files []string
+	}{
+		{"zz-b", ErrInvalidMagic, []string{"home/.terminfo/z/zz-b", "home/.terminfo/7a/zz-b", "a/z/zz-b", "a/7a/zz-b", "b/z/zz-b"}},
+		{"zz-d", ErrFileNotFound, []string{"home/.terminfo/z/zz-d", "home/.terminfo/7a/zz-d", "a/z/zz-d", "a/7a/zz-d", "b/z/zz-d", "b/7a/zz-d", "missing/z/zz-d", "missing/7a/zz-d"}},
+	}
+	for i, test := range tests {
+		ClearCache()
+		_, err := Load(test.name)
+		var lerr *LoadError
+		if !errors.As(err, &lerr) {
+			t.Fatalf("test %d expected *LoadError, got: %T %v", i, err, err)
+		}
+		if !errors.Is(err, test.exp) {
+			t.Errorf("test %d expected %v, got: %v", i, test.exp, lerr.Err)
+		}
+		if exp := []string{dir("home/.terminfo"), dir("a"), dir("b"), dir("missing")}; len(lerr.Dirs) > len(exp) || !reflect.DeepEqual(lerr.Dirs, exp[:len(lerr.Dirs)]) {
+			t.Errorf("test %d expected dirs %v, got: %v", i, exp, lerr.Dirs)
+		}
+		var files []string
+		for _, a := range lerr.Attempts {
+			files = append(files, a.File)
+		}
+		var exp []string
+		for _, f := range test.files {
+			exp = append(exp, dir(f))
+		}
+		if !reflect.DeepEqual(files, exp) {
+			t.Errorf("test %d expected files %v, got: %v", i, exp, files)
+		}
+	}
+
+	// check the reasons (the permissions are not enforced for root)
+	if _, err := Load("zz-c"); os.Getuid() != 0 {
+		attempts := err.(*LoadError).Attempts
+		if !os.IsNotExist(attempts[0].Err) {
+			t.Errorf("expected missing file, got: %v", attempts[0].Err)
+		}
+		if !os.IsPermission(attempts[4].Err) {
+			t.Errorf("expected permission denied, got: %v", attempts[4].Err)
+		}
+	}
+
+	// check no directories found
+	defer setenv("HOME", dir("missing"), "TERMINFO_DIRS", "")()
+	if _, err := Load("zz-a"); !errors.Is(err, ErrDatabaseDirectoryNotFound) {
+		t.Errorf("expected ErrDatabaseDirectoryNotFound, got: %v", err)
+	}
+
+	// check open
+	_, err = Open(dir("a"), "zz-b")
+	if !errors.Is(err, ErrFileNotFound) || !strings.Contains(err.Error(), dir("a")) {
+		t.Errorf("expected ErrFileNotFound with the directory, got: %v", err)
+	}
+}
@@ -0,0 +1,189 @@
+package terminfo
+
+// Clone returns a deep copy of the terminfo.
+func (ti *Terminfo) Clone() *Terminfo {
+	z := *ti
+	z.Names = append([]string(nil), ti.Names...)
+	for i, s := range ti.Strings {
+		if s != nil {
+			z.Strings[i] = append([]byte{}, s...)
+		}
+	}
+	z.ExtBools, z.ExtBoolsM = cloneBools(ti.ExtBools), cloneBools(ti.ExtBoolsM)
+	z.ExtNums, z.ExtNumsM = cloneNums(ti.ExtNums), cloneBools(ti.ExtNumsM)
+	z.ExtStrings, z.ExtStringsM = cloneBytes(ti.ExtStrings), cloneBools(ti.ExtStringsM)
+	z.ExtBoolNames = cloneBytes(ti.ExtBoolNames)
+	z.ExtNumNames = cloneBytes(ti.ExtNumNames)
+	z.ExtStringNames = cloneBytes(ti.ExtStringNames)
+	z.static, z.extIndex = new(StaticVars), nil
+	if ti.extIndex != nil {
+		z.indexExt()
+	}
+	return &z
+}
+
+// Merge returns a new terminfo composed of base and overlays, following the
+// same rules as the terminfo use= capability: the overlays are applied in
+// order, and a capability is taken from the first of base and overlays that
+// either defines or cancels (@) it. Extended capabilities are merged by name,
+// with the capabilities only defined by an overlay appended to those of base.
+//
+// Cancelled capabilities remain cancelled in the returned terminfo, so that
+// merging it again with other overlays does not define them. The names and
+// file of base are retained. Neither base nor overlays are modified.
+func Merge(base *Terminfo, overlays ...*Terminfo) *Terminfo {
+	ti := base.Clone()
+	for _, o := range overlays {
+		ti.merge(o)
+	}
+	ti.indexExt()
+	return ti
+}
+
+// merge merges the capabilities of o that are absent in ti.
+func (ti *Terminfo) merge(o *Terminfo) {
+	// standard caps
+	for i := 0; i < CapCountBool; i++ {
+		if ti.State(CapKindBool, i) == CapAbsent {
+			switch o.State(CapKindBool, i) {
+			case CapPresent:
+				ti.Bools[i] = o.Bools[i]
+				ti.BoolsP.Set(i)
+			case CapCancelled:
+				ti.BoolsM.Set(i)
+			}
+		}
+	}
+	for i := 0; i < CapCountNum; i++ {
+		if ti.State(CapKindNum, i) == CapAbsent {
+			switch o.State(CapKindNum, i) {
+			case CapPresent:
+				ti.Nums[i] = o.Nums[i]
+				ti.NumsP.Set(i)
+			case CapCancelled:
+				ti.NumsM.Set(i)
+			}
+		}
+	}
+	for i := 0; i < CapCountString; i++ {
+		if ti.State(CapKindString, i) == CapAbsent {
+			switch o.State(CapKindString, i) {
+			case CapPresent:
+				ti.Strings[i] = append([]byte{}, o.Strings[i]...)
+				ti.StringsP.Set(i)
+			case CapCancelled:
+				ti.StringsM.Set(i)
+			}
+		}
+	}
+
+	// extended caps
+	for _, c := range o.ExtCaps() {
+		kind, i, ok := ti.lookupExt(c.Name)
+		switch {
+		case !ok:
+			kind, i = c.Kind, ti.addExt(c.Kind, c.Name)
+		case kind != c.Kind || ti.State(kind, i) != CapAbsent:
+			continue
+		}
+		ti.mergeExt(o, kind, i, c.Index)
+	}
+}
+
+// addExt adds the extended capability name of kind, returning its index.
+func (ti *Terminfo) addExt(kind CapKind, name string) int {
+	var names *map[int][]byte
+	switch kind {
+	case CapKindExtBool:
+		names = &ti.ExtBoolNames
+	case CapKindExtNum:
+		names = &ti.ExtNumNames
+	case CapKindExtString:
+		names = &ti.ExtStringNames
+	}
+	if *names == nil {
+		*names = make(map[int][]byte)
+	}
+	i := len(*names)
+	(*names)[i] = []byte(name)
+	return i
+}
+
+// mergeExt sets the extended capability i of kind to the value and state of
+// the extended capability j of o.
+func (ti *Terminfo) mergeExt(o *Terminfo, kind CapKind, i, j int) {
+	cancelled := o.State(kind, j) == CapCancelled
+	switch kind {
+	case CapKindExtBool:
+		if ti.ExtBools == nil {
+			ti.ExtBools = make(map[int]bool)
+		}
+		ti.ExtBools[i] = o.ExtBools[j]
+		if cancelled {
+			ti.ExtBoolsM = setBool(ti.ExtBoolsM, i)
+		}
+	case CapKindExtNum:
+		if n, ok := o.ExtNums[j]; ok {
+			if ti.ExtNums == nil {
+				ti.ExtNums = make(map[int]int)
+			}
+			ti.ExtNums[i] = n
+		} else if cancelled {
+			ti.ExtNumsM = setBool(ti.ExtNumsM, i)
+		}
+	case CapKindExtString:
+		if s, ok := o.ExtStrings[j]; ok {
+			if ti.ExtStrings == nil {
+				ti.ExtStrings = make(map[int][]byte)
+			}
+			ti.ExtStrings[i] = append([]byte{}, s...)
+		} else if cancelled {
+			ti.ExtStringsM = setBool(ti.ExtStringsM, i)
+		}
+	}
+}
+
+// setBool sets i in m, creating m if nil.
+func setBool(m map[int]bool, i int) map[int]bool {
+	if m == nil {
+		m = make(map[int]bool)
+	}
+	m[i] = true
+	return m
+}
+
+// cloneBools returns a copy of m.
+func cloneBools(m map[int]bool) map[int]bool {
+	if m == nil {
+		return nil
+	}
+	z := make(map[int]bool, len(m))
+	for k, v := range m {
+		z[k] = v
+	}
+	return z
+}
+
+// cloneNums returns a copy of m.
+func cloneNums(m map[int]int) map[int]int {
+	if m == nil {
+		return nil
+	}
+	z := make(map[int]int, len(m))
+	for k, v := range m {
+		z[k] = v
+	}
+	return z
+}
+
+// cloneBytes returns a deep copy of m.
+func cloneBytes(m map[int][]byte) map[int][]byte {
+	if m == nil {
+		return nil
+	}
+	z := make(map[int][]byte, len(m))
+	for k, v := range m {
+		z[k] = append([]byte{}, v...)
+	}
+	return z
+}
@@ -0,0 +1,100 @@
+package terminfo
+
+import (
+	"testing"
+)
+
+func TestClone(t *testing.T) {
+	ti := withCaps(&Terminfo{
+		Names:          []string{"test"},
+		ExtStrings:     map[int][]byte{0: []byte("\x1b[%p1%d q")},
+		ExtStringNames: map[int][]byte{0: []byte("Ss")},
+	}, []int{AutoRightMargin}, map[int]int{Columns: 80}, map[int]string{Bell: "\a"})
+	ti.indexExt()
+	z := ti.Clone()
+	z.Names[0] = "clone"
+	z.Strings[Bell][0] = 'x'
+	z.ExtStrings[0][0] = 'x'
+	z.Nums[Columns] = 132
+	z.addExt(CapKindExtBool, "XT")
+	if ti.Names[0] != "test" || string(ti.Strings[Bell]) != "\a" || ti.ExtStrings[0][0] != '\x1b' || ti.Nums[Columns] != 80 {
+		t.Errorf("expected clone to not modify original")
+	}
+	if _, _, ok := ti.lookupExt("XT"); ok {
+		t.Errorf("expected clone to not modify original extended index")
+	}
+	if !z.Has(AutoRightMargin) || string(z.ExtString("Ss")) != "x[%p1%d q" || string(z.ExtBoolNames[0]) != "XT" {
+		t.Errorf("expected clone to have caps")
+	}
+}
+
+func TestMerge(t *testing.T) {
+	base := withCaps(&Terminfo{
+		Names:          []string{"base"},
+		ExtBools:       map[int]bool{0: false},
+		ExtBoolNames:   map[int][]byte{0: []byte("AX")},
+		ExtStrings:     map[int][]byte{0: []byte("base")},
+		ExtStringNames: map[int][]byte{0: []byte("Ss")},
+	}, []int{AutoRightMargin}, nil, map[int]string{Bell: "\a"})
+	base.NumsM.Set(Columns)
+	base.ExtBoolsM = map[int]bool{0: true}
+	overlay := withCaps(&Terminfo{
+		ExtBools:       map[int]bool{0: true, 1: true},
+		ExtBoolNames:   map[int][]byte{0: []byte("AX"), 1: []byte("XT")},
+		ExtNums:        map[int]int{},
+		ExtNumsM:       map[int]bool{0: true},
+		ExtNumNames:    map[int][]byte{0: []byte("U8")},
+		ExtStrings:     map[int][]byte{0: []byte("overlay"), 1: []byte("\x1b[2 q")},
+		ExtStringNames: map[int][]byte{0: []byte("Ss"), 1: []byte("Se")},
+	}, []int{AutoLeftMargin}, map[int]int{Columns: 80, Lines: 24}, map[int]string{Bell: "x", CarriageReturn: "\r"})
+	overlay.StringsM.Set(ClearScreen)
+	last := withCaps(&Terminfo{
+		ExtNums:     map[int]int{0: 1},
+		ExtNumNames: map[int][]byte{0: []byte("U8")},
+	}, nil, map[int]int{Lines: 50}, map[int]string{ClearScreen: "\x1b[H\x1b[2J"})
+
+	ti := Merge(base, overlay, last)
+	tests := []struct {
+		name string
+		exp  CapState
+	}{
+		{"am", CapPresent},
+		{"bw", CapPresent},
+		{"cols", CapCancelled},
+		{"lines", CapPresent},
+		{"bel", CapPresent},
+		{"cr", CapPresent},
+		{"clear", CapCancelled},
+		{"AX", CapCancelled},
+		{"XT", CapPresent},
+		{"U8", CapCancelled},
+		{"Ss", CapPresent},
+		{"Se", CapPresent},
+	}
+	for _, test := range tests {
+		if s := ti.CapState(test.name); s != test.exp {
+			t.Errorf("%s expected %s, got: %s", test.name, test.exp, s)
+		}
+	}
+	if ti.Names[0] != "base" {
+		t.Errorf("expected names of base, got: %v", ti.Names)
+	}
+	if n := ti.Num(Lines); n != 24 {
+		t.Errorf("expected lines 24, got: %d", n)
+	}
+	if s := string(ti.Strings[Bell]); s != "\a" {
+		t.Errorf("expected bel %q, got: %q", "\a", s)
+	}
+	if s := string(ti.ExtString("Ss")); s != "base" {
+		t.Errorf("expected Ss %q, got: %q", "base", s)
+	}
+	if s := string(ti.ExtString("Se")); s != "\x1b[2 q" {
+		t.Errorf("expected Se %q, got: %q", "\x1b[2 q", s)
+	}
+	if ti.ExtBool("AX") || !ti.ExtBool("XT") || ti.ExtNum("U8") != -1 {
+		t.Errorf("expected AX false, XT true and U8 -1")
+	}
+	if base.Has(AutoLeftMargin) || base.ExtString("Se") != nil {
+		t.Errorf("expected base to not be modified")
+	}
+}
@@ -0,0 +1,105 @@
+package terminfo
+
+import (
+	"io"
+)
+
+// InputMarkers are the sequences a terminal sends to mark the start and end
+// of a bracketed paste and changes of focus, as defined by the PS, PE, kxIN
+// and kxOUT extended strings. Markers the terminal does not define are nil.
+type InputMarkers struct {
+	// PasteStart is the sequence sent before pasted text.
+	PasteStart []byte
+
+	// PasteEnd is the sequence sent after pasted text.
+	PasteEnd []byte
+
+	// FocusIn is the sequence sent when the terminal gains focus.
+	FocusIn []byte
+
+	// FocusOut is the sequence sent when the terminal loses focus.
+	FocusOut []byte
+}
+
+// InputMarkers returns the input markers for the terminal.
+func (ti *Terminfo) InputMarkers() InputMarkers {
+	return InputMarkers{
+		PasteStart: ti.ExtString("PS"),
+		PasteEnd:   ti.ExtString("PE"),
+		FocusIn:    ti.ExtString("kxIN"),
+		FocusOut:   ti.ExtString("kxOUT"),
+	}
+}
+
+// writeExt writes the extended string name to w, returning err if the
+// terminal does not have the extended string.
+func (ti *Terminfo) writeExt(w io.Writer, name string, err error) error {
+	s := ti.ExtString(name)
+	if s == nil {
+		return err
+	}
+	_, err = w.Write(s)
+	return err
+}
+
+// HasBracketedPaste determines if the terminal supports bracketed paste
+// through the BE and BD extended strings.
+func (ti *Terminfo) HasBracketedPaste() bool {
+	return ti.ExtString("BE") != nil && ti.ExtString("BD") != nil
+}
+
+// EnableBracketedPaste writes the BE extended string to writer w.
+func (ti *Terminfo) EnableBracketedPaste(w io.Writer) error {
+	return ti.writeExt(w, "BE", ErrNoBracketedPaste)
+}
+
+// DisableBracketedPaste writes the BD extended string to writer w.
+func (ti *Terminfo) DisableBracketedPaste(w io.Writer) error {
+	return ti.writeExt(w, "BD", ErrNoBracketedPaste)
+}
+
+// HasFocusEvents determines if the terminal supports focus reporting through
+// the fe and fd extended strings.
+func (ti *Terminfo) HasFocusEvents() bool {
+	return ti.ExtString("fe") != nil && ti.ExtString("fd") != nil
+}
+
+// EnableFocusEvents writes the fe extended string to writer w.
+func (ti *Terminfo) EnableFocusEvents(w io.Writer) error {
+	return ti.writeExt(w, "fe", ErrNoFocusEvents)
+}
+
+// DisableFocusEvents writes the fd extended string to writer w.
+func (ti *Terminfo) DisableFocusEvents(w io.Writer) error {
+	return ti.writeExt(w, "fd", ErrNoFocusEvents)
+}
+
+// HasSync determines if the terminal supports synchronized updates through
+// the Sync extended string.
+func (ti *Terminfo) HasSync() bool {
+	return ti.ExtString("Sync") != nil
+}
+
+// Synchronized calls f between the strings that begin and end a synchronized
+// update, written to writer w, so that the terminal renders all output
+// written by f at once. When the terminal does not support synchronized
+// updates, f is called as is.
+func (ti *Terminfo) Synchronized(w io.Writer, f func() error) error {
+	s := ti.ExtString("Sync")
+	if s == nil {
+		return f()
+	}
+
+	// begin
+	if _, err := io.WriteString(w, ti.StaticVars().Printf(s, 1)); err != nil {
+		return err
+	}
+
+	// always end the update, even when f fails
+	err := f()
+	if _, werr := io.WriteString(w, ti.StaticVars().Printf(s, 2)); err == nil {
+		err = werr
+	}
+
+	return err
+}
@@ -0,0 +1,95 @@
+package terminfo
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestModes(t *testing.T) {
+	ti := &Terminfo{
+		ExtStrings: map[int][]byte{
+			0: []byte("\x1b[?2004h"),
+			1: []byte("\x1b[?2004l"),
+			2: []byte("\x1b[200~"),
+			3: []byte("\x1b[201~"),
+			4: []byte("\x1b[?1004h"),
+			5: []byte("\x1b[?1004l"),
+			6: []byte("\x1b[I"),
+			7: []byte("\x1b[O"),
+		},
+		ExtStringNames: map[int][]byte{
+			0: []byte("BE"),
+			1: []byte("BD"),
+			2: []byte("PS"),
+			3: []byte("PE"),
+			4: []byte("fe"),
+			5: []byte("fd"),
+			6: []byte("kxIN"),
+			7: []byte("kxOUT"),
+		},
+	}
+	if !ti.HasBracketedPaste() || !ti.HasFocusEvents() {
+		t.Fatalf("expected bracketed paste and focus events")
+	}
+	buf := new(bytes.Buffer)
+	for _, f := range []func(*Terminfo) error{
+		func(ti *Terminfo) error { return ti.EnableBracketedPaste(buf) },
+		func(ti *Terminfo) error { return ti.EnableFocusEvents(buf) },
+		func(ti *Terminfo) error { return ti.DisableFocusEvents(buf) },
+		func(ti *Terminfo) error { return ti.DisableBracketedPaste(buf) },
+	} {
+		if err := f(ti); err != nil {
+			t.Fatalf("expected no error, got: %v", err)
+		}
+	}
+	if exp := "\x1b[?2004h\x1b[?1004h\x1b[?1004l\x1b[?2004l"; buf.String() != exp {
+		t.Errorf("expected %q, got: %q", exp, buf.String())
+	}
+	exp := InputMarkers{
+		PasteStart: []byte("\x1b[200~"),
+		PasteEnd:   []byte("\x1b[201~"),
+		FocusIn:    []byte("\x1b[I"),
+		FocusOut:   []byte("\x1b[O"),
+	}
+	if m := ti.InputMarkers(); !reflect.DeepEqual(m, exp) {
+		t.Errorf("expected %#v, got: %#v", exp, m)
+	}
+	none := &Terminfo{}
+	if err := none.EnableBracketedPaste(buf); err != ErrNoBracketedPaste {
+		t.Errorf("expected ErrNoBracketedPaste, got: %v", err)
+	}
+	if err := none.EnableFocusEvents(buf); err != ErrNoFocusEvents {
+		t.Errorf("expected ErrNoFocusEvents, got: %v", err)
+	}
+}
+
+func TestSynchronized(t *testing.T) {
+	ti := &Terminfo{
+		ExtStrings:     map[int][]byte{0: []byte("\x1b[?2026%?%p1%{1}%=%th%el%;")},
+		ExtStringNames: map[int][]byte{0: []byte("Sync")},
+	}
+	errFrame := errors.New("frame error")
+	for _, test := range []struct {
+		ti  *Terminfo
+		err error
+		exp string
+	}{
+		{ti, nil, "\x1b[?2026hframe\x1b[?2026l"},
+		{ti, errFrame, "\x1b[?2026hframe\x1b[?2026l"},
+		{&Terminfo{}, nil, "frame"},
+	} {
+		buf := new(bytes.Buffer)
+		err := test.ti.Synchronized(buf, func() error {
+			buf.WriteString("frame")
+			return test.err
+		})
+		if err != test.err {
+			t.Errorf("expected error %v, got: %v", test.err, err)
+		}
+		if buf.String() != test.exp {
+			t.Errorf("expected %q, got: %q", test.exp, buf.String())
+		}
+	}
+}
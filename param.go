@@ -2,7 +2,6 @@ package terminfo
 
 import (
 	"bytes"
-	"fmt"
 	"io"
 	"strconv"
 	"strings"
@@ -26,21 +25,36 @@ type parametizer struct {
 	// skipElse keeps the state of skipping else.
 	skipElse bool
 
+	// termcap is set when the params were pushed on the stack for a termcap
+	// style string.
+	termcap bool
+
+	// incremented is set when the params were incremented by %i.
+	incremented bool
+
 	// buf is the result buffer.
 	buf *bytes.Buffer
 
 	// params are the parameters to interpolate.
 	params [9]interface{}
 
-	// vars are dynamic variables.
+	// vars are the dynamic variables, which are cleared for each evaluation.
 	vars [26]interface{}
+
+	// static are the static variables.
+	static *StaticVars
 }
 
-// staticVars are the static, global variables.
-var staticVars = struct {
+// StaticVars are the static variables (%PA to %PZ) of parameterized strings,
+// which, unlike the dynamic variables (%Pa to %Pz), persist between
+// evaluations. StaticVars is safe for concurrent use.
+type StaticVars struct {
 	vars [26]interface{}
-	sync.Mutex
-}{}
+	mu   sync.Mutex
+}
+
+// staticVars are the static variables used by Printf and Fprintf.
+var staticVars StaticVars
 
 var parametizerPool = sync.Pool{
 	New: func() interface{} {
@@ -51,9 +65,9 @@ var parametizerPool = sync.Pool{
 }
 
 // newParametizer returns a new initialized parametizer from the pool.
-func newParametizer(z []byte) *parametizer {
+func newParametizer(z []byte, static *StaticVars) *parametizer {
 	p := parametizerPool.Get().(*parametizer)
-	p.z = z
+	p.z, p.static = z, static
 
 	return p
 }
@@ -61,11 +75,12 @@ func newParametizer(z []byte) *parametizer {
 // reset resets the parametizer.
 func (p *parametizer) reset() {
 	p.pos, p.nest = 0, 0
+	p.termcap, p.incremented = false, false
 
 	p.s.reset()
 	p.buf.Reset()
 
-	p.params, p.vars = [9]interface{}{}, [26]interface{}{}
+	p.params, p.vars, p.static = [9]interface{}{}, [26]interface{}{}, nil
 
 	parametizerPool.Put(p)
 }
@@ -118,6 +133,9 @@ func (p *parametizer) scanTextFn() stateFn {
 }
 
 func (p *parametizer) scanCodeFn() stateFn {
+	var f format
+	f, p.pos = scanFormat(p.z, p.pos)
+
 	ch, err := p.peek()
 	if err != nil {
 		return nil
@@ -127,36 +145,20 @@ func (p *parametizer) scanCodeFn() stateFn {
 	case '%':
 		p.buf.WriteByte('%')
 
-	case ':':
-		// this character is used to avoid interpreting "%-" and "%+" as operators.
-		// the next character is where the format really begins.
-		p.pos++
-		_, err = p.peek()
-		if err != nil {
-			return nil
-		}
-		return p.scanFormatFn
-
-	case '#', ' ', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', '.':
-		return p.scanFormatFn
-
-	case 'o':
-		p.buf.WriteString(strconv.FormatInt(int64(p.s.popInt()), 8))
-
-	case 'd':
-		p.buf.WriteString(strconv.Itoa(p.s.popInt()))
-
-	case 'x':
-		p.buf.WriteString(strconv.FormatInt(int64(p.s.popInt()), 16))
-
-	case 'X':
-		p.buf.WriteString(strings.ToUpper(strconv.FormatInt(int64(p.s.popInt()), 16)))
+	case 'o', 'd', 'x', 'X':
+		f.writeInt(p.buf, p.s.popInt(), ch)
 
 	case 's':
-		p.buf.WriteString(p.s.popString())
+		f.writeString(p.buf, p.s.popString())
 
 	case 'c':
-		p.buf.WriteByte(p.s.popByte())
+		// as with ncurses, the format is ignored and 0 is output as 0200, as
+		// it cannot be sent.
+		c := p.s.popInt()
+		if c == 0 {
+			c = 0200
+		}
+		p.buf.WriteByte(byte(c))
 
 	case 'p':
 		p.pos++
@@ -256,9 +258,19 @@ func (p *parametizer) scanCodeFn() stateFn {
 		p.s.push(^p.s.popInt())
 
 	case 'i':
+		// as with ncurses, only the first %i increments the params, which,
+		// for termcap style strings, are also assigned to the bottom of the
+		// stack
+		if p.incremented {
+			break
+		}
+		p.incremented = true
 		for i := range p.params[:2] {
 			if n, ok := p.params[i].(int); ok {
 				p.params[i] = n + 1
+				if p.termcap && i < len(p.s) {
+					p.s[i] = n + 1
+				}
 			}
 		}
 
@@ -277,41 +289,219 @@ func (p *parametizer) scanCodeFn() stateFn {
 	return p.scanTextFn
 }
 
-func (p *parametizer) scanFormatFn() stateFn {
-	// the character was already read, so no need to check the error.
-	ch, _ := p.peek()
+// format is a printf style output format, parsed from
+// %[[:]flags][width[.precision]], where the flags are '-', '#' and ' ', and
+// the ':' is needed before a '-' to not have it read as the subtraction
+// operator.
+type format struct {
+	// minus left aligns the output.
+	minus bool
 
-	// 6 should be the maximum length of a format string, for example "%:-9.9d".
-	f := []byte{'%', ch, 0, 0, 0, 0}
+	// alt uses the alternate form, prefixing 0 for %o and 0x for %x.
+	alt bool
 
-	var err error
+	// space prefixes a space for positive numbers.
+	space bool
 
-	for {
-		p.pos++
-		ch, err = p.peek()
-		if err != nil {
-			return nil
+	// zero pads numbers with zeros instead of spaces.
+	zero bool
+
+	// width is the minimum width of the output.
+	width int
+
+	// prec is the minimum number of digits for numbers, or the maximum length
+	// for strings, when hasPrec is set.
+	prec    int
+	hasPrec bool
+}
+
+// maxFormatWidth is the maximum width and precision of a format. As with
+// ncurses, the whole format is ignored when it is exceeded.
+const maxFormatWidth = 10000
+
+// scanFormat scans the format in z starting at pos, returning the format and
+// the position after it. As with ncurses, the format is scanned before every
+// operator and only used by the output operators, so that, for example,
+// "%:+d" is the addition operator followed by the text "d". The empty format
+// is returned when there is no format, or when it is invalid.
+func scanFormat(z []byte, pos int) (format, int) {
+	var f format
+	var v int
+	var dot, minus, invalid bool
+loop:
+	for ; pos < len(z); pos++ {
+		switch ch := z[pos]; {
+		case ch == ':':
+			minus = true
+		case ch == '-' && minus:
+			f.minus = true
+		case ch == '#':
+			f.alt = true
+		case ch == ' ':
+			f.space = true
+		case ch == '.':
+			if dot {
+				invalid = true
+			}
+			dot, f.width, v = true, v, 0
+		case '0' <= ch && ch <= '9':
+			if ch == '0' && v == 0 && !dot {
+				f.zero = true
+			}
+			if v = v*10 + int(ch-'0'); v > maxFormatWidth {
+				invalid, v = true, maxFormatWidth
+			}
+		default:
+			break loop
 		}
+	}
+	if invalid {
+		return format{}, pos
+	}
+	if dot {
+		f.prec, f.hasPrec = v, true
+	} else {
+		f.width = v
+	}
+	return f, pos
+}
 
-		f = append(f, ch)
-		switch ch {
-		case 'o', 'd', 'x', 'X':
-			fmt.Fprintf(p.buf, string(f), p.s.popInt())
+// termcapParams returns the number of params to push on the stack before
+// evaluating z, for termcap style strings, such as "\x1b[%i%d;%dR", that pop
+// the params without pushing them with %p1 to %p9. As with ncurses, it is the
+// number of params popped by the operators before anything else is pushed,
+// up to 2.
+func termcapParams(z []byte) int {
+	level, n := -1, 0
+	pop := func() {
+		if level < 0 && n < 2 {
+			n++
+		}
+	}
+	for pos := 0; pos < len(z); pos++ {
+		if z[pos] != '%' {
+			continue
+		}
+		if _, pos = scanFormat(z, pos+1); pos >= len(z) {
 			break
+		}
+		switch z[pos] {
+		case 'p':
+			if pos++; pos < len(z) && '1' <= z[pos] && z[pos] <= '9' {
+				return 0
+			}
+			level++
+		case 'g':
+			level++
+			pos++
+		case '\'':
+			level++
+			pos += 2
+		case '{':
+			level++
+			for pos++; pos < len(z) && '0' <= z[pos] && z[pos] <= '9'; pos++ {
+			}
+		case 'P':
+			pos++
+		case 'd', 'o', 'x', 'X', 'c', '+', '-', '*', '/', 'm', 'A', 'O', '&', '|', '^', '=', '<', '>':
+			pop()
+			level--
+		case 's', 'l', '!', '~':
+			pop()
+		}
+	}
+	return n
+}
 
-		case 's':
-			fmt.Fprintf(p.buf, string(f), p.s.popString())
-			break
+// String returns the format as a printf style format, without the
+// conversion.
+func (f format) String() string {
+	z := []byte{'%'}
+	for _, c := range []struct {
+		set bool
+		ch  byte
+	}{{f.minus, '-'}, {f.alt, '#'}, {f.space, ' '}, {f.zero, '0'}} {
+		if c.set {
+			z = append(z, c.ch)
+		}
+	}
+	if f.width != 0 {
+		z = strconv.AppendInt(z, int64(f.width), 10)
+	}
+	if f.hasPrec {
+		z = strconv.AppendInt(append(z, '.'), int64(f.prec), 10)
+	}
+	return string(z)
+}
 
-		case 'c':
-			fmt.Fprintf(p.buf, string(f), p.s.popByte())
-			break
+// writeInt writes n to buf using the format and the conversion, which is one
+// of 'd', 'o', 'x' or 'X'. As with ncurses, numbers are 32-bit ints, and are
+// unsigned for 'o', 'x' and 'X'.
+func (f format) writeInt(buf *bytes.Buffer, n int, conv byte) {
+	var prefix, digits string
+	switch conv {
+	case 'd':
+		i := int64(int32(n))
+		switch {
+		case i < 0:
+			prefix, i = "-", -i
+		case f.space:
+			prefix = " "
+		}
+		digits = strconv.FormatInt(i, 10)
+	case 'o':
+		digits = strconv.FormatUint(uint64(uint32(n)), 8)
+	case 'x', 'X':
+		u := uint32(n)
+		digits = strconv.FormatUint(uint64(u), 16)
+		if f.alt && u != 0 {
+			prefix = "0" + string(conv)
+		}
+		if conv == 'X' {
+			digits = strings.ToUpper(digits)
 		}
 	}
 
-	p.pos++
+	// the precision is the minimum number of digits, with no digits for 0
+	// with a precision of 0
+	if f.hasPrec {
+		if f.prec == 0 && digits == "0" {
+			digits = ""
+		}
+		if len(digits) < f.prec {
+			digits = strings.Repeat("0", f.prec-len(digits)) + digits
+		}
+	}
+	if conv == 'o' && f.alt && !strings.HasPrefix(digits, "0") {
+		digits = "0" + digits
+	}
 
-	return p.scanTextFn
+	// zeros are ignored when left aligning or when there is a precision
+	if pad := f.width - len(prefix) - len(digits); pad > 0 && f.zero && !f.minus && !f.hasPrec {
+		digits = strings.Repeat("0", pad) + digits
+	}
+	f.writePadded(buf, prefix+digits)
+}
+
+// writeString writes s to buf using the format, truncating it to the
+// precision.
+func (f format) writeString(buf *bytes.Buffer, s string) {
+	if f.hasPrec && f.prec < len(s) {
+		s = s[:f.prec]
+	}
+	f.writePadded(buf, s)
+}
+
+// writePadded writes s to buf, padded with spaces to the width.
+func (f format) writePadded(buf *bytes.Buffer, s string) {
+	pad := f.width - len(s)
+	if pad > 0 && !f.minus {
+		buf.WriteString(strings.Repeat(" ", pad))
+	}
+	buf.WriteString(s)
+	if pad > 0 && f.minus {
+		buf.WriteString(strings.Repeat(" ", pad))
+	}
 }
 
 func (p *parametizer) pushParamFn() stateFn {
@@ -339,9 +529,9 @@ func (p *parametizer) setDsVarFn() stateFn {
 	}
 
 	if ch >= 'A' && ch <= 'Z' {
-		staticVars.Lock()
-		staticVars.vars[int(ch-'A')] = p.s.pop()
-		staticVars.Unlock()
+		p.static.mu.Lock()
+		p.static.vars[int(ch-'A')] = p.s.pop()
+		p.static.mu.Unlock()
 	} else if ch >= 'a' && ch <= 'z' {
 		p.vars[int(ch-'a')] = p.s.pop()
 	}
@@ -356,17 +546,17 @@ func (p *parametizer) getDsVarFn() stateFn {
 		return nil
 	}
 
-	var a byte
-	if ch >= 'A' && ch <= 'Z' {
-		a = 'A'
-	} else if ch >= 'a' && ch <= 'z' {
-		a = 'a'
+	switch {
+	case ch >= 'A' && ch <= 'Z':
+		p.static.mu.Lock()
+		p.s.push(p.static.vars[int(ch-'A')])
+		p.static.mu.Unlock()
+	case ch >= 'a' && ch <= 'z':
+		p.s.push(p.vars[int(ch-'a')])
+	default:
+		p.s.push(0)
 	}
 
-	staticVars.Lock()
-	p.s.push(staticVars.vars[int(ch-a)])
-	staticVars.Unlock()
-
 	p.pos++
 
 	return p.scanTextFn
@@ -470,8 +660,21 @@ func (p *parametizer) skipElseFn() stateFn {
 }
 
 // Printf evaluates a parameterized terminfo value z, interpolating params.
+// The static variables are shared by all calls to Printf and Fprintf.
 func Printf(z []byte, params ...interface{}) string {
-	p := newParametizer(z)
+	return staticVars.Printf(z, params...)
+}
+
+// Fprintf evaluates a parameterized terminfo value z, interpolating params and
+// writing to w.
+func Fprintf(w io.Writer, z []byte, params ...interface{}) {
+	w.Write([]byte(Printf(z, params...)))
+}
+
+// Printf evaluates a parameterized terminfo value z using the static
+// variables v, interpolating params.
+func (v *StaticVars) Printf(z []byte, params ...interface{}) string {
+	p := newParametizer(z, v)
 	defer p.reset()
 
 	// make sure we always have 9 parameters -- makes it easier
@@ -480,11 +683,27 @@ func Printf(z []byte, params ...interface{}) string {
 		p.params[i] = params[i]
 	}
 
+	// as with ncurses, push the params of termcap style strings, with the
+	// first param on top
+	if n := termcapParams(z); n != 0 {
+		for i := n - 1; i >= 0; i-- {
+			p.s.push(p.params[i])
+		}
+		p.termcap = true
+	}
+
 	return p.exec()
 }
 
-// Fprintf evaluates a parameterized terminfo value z, interpolating params and
-// writing to w.
-func Fprintf(w io.Writer, z []byte, params ...interface{}) {
-	w.Write([]byte(Printf(z, params...)))
+// Fprintf evaluates a parameterized terminfo value z using the static
+// variables v, interpolating params and writing to w.
+func (v *StaticVars) Fprintf(w io.Writer, z []byte, params ...interface{}) {
+	w.Write([]byte(v.Printf(z, params...)))
+}
+
+// Reset clears the static variables.
+func (v *StaticVars) Reset() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.vars = [26]interface{}{}
 }
@@ -0,0 +1,154 @@
+package terminfo
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestPrintfVars(t *testing.T) {
+	tests := []struct {
+		z   string
+		v   []interface{}
+		exp string
+	}{
+		// dynamic variables
+		{"%p1%Pa%ga%d%ga%d", []interface{}{5}, "55"},
+		{"%p1%Pa%p2%Pb%gb%ga%-%d", []interface{}{5, 3}, "-2"},
+		{"%ga%d", nil, "0"},
+		{"%p1%Pz%gz%s", []interface{}{"x"}, "x"},
+		// invalid names push 0
+		{"%p1%P1%g1%d", []interface{}{5}, "0"},
+		// conditionals test ints, with 0 being false
+		{"%?%p1%t1%e0%;", []interface{}{2}, "1"},
+		{"%?%p1%t1%e0%;", []interface{}{0}, "0"},
+		{"%?%p1%{3}%=%t1%e0%;", []interface{}{3}, "1"},
+		{"%?%p1%p2%A%t1%e0%;", []interface{}{3, 4}, "1"},
+		{"%p1%{2}%>%d", []interface{}{3}, "1"},
+		// chars are numbers
+		{"%p1%' '%+%c", []interface{}{1}, "!"},
+		{"%'a'%d", nil, "97"},
+	}
+	for i, test := range tests {
+		if s := Printf([]byte(test.z), test.v...); s != test.exp {
+			t.Errorf("test %d %q expected %q, got: %q", i, test.z, test.exp, s)
+		}
+	}
+}
+
+func TestStaticVars(t *testing.T) {
+	set, get := []byte("%p1%PA"), []byte("%gA%d")
+
+	// static variables persist between evaluations
+	a, b := new(StaticVars), new(StaticVars)
+	a.Printf(set, 3)
+	if s := a.Printf(get); s != "3" {
+		t.Errorf("expected %q, got: %q", "3", s)
+	}
+	if s := b.Printf(get); s != "0" {
+		t.Errorf("expected static variables to not be shared, got: %q", s)
+	}
+	a.Reset()
+	if s := a.Printf(get); s != "0" {
+		t.Errorf("expected static variables to be cleared, got: %q", s)
+	}
+
+	// each terminfo has its own static variables
+	x, err := Decode(stateEntry)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	y := x.Clone()
+	x.StaticVars().Printf(set, 4)
+	if s := x.StaticVars().Printf(get); s != "4" {
+		t.Errorf("expected %q, got: %q", "4", s)
+	}
+	if s := y.StaticVars().Printf(get); s != "0" {
+		t.Errorf("expected terminfo static variables to not be shared, got: %q", s)
+	}
+}
+
+func TestPrintfEntryVars(t *testing.T) {
+	// setf maps the color using the dynamic variable a
+	ti := openTerm(t, "xterm-16color")
+	tests := []struct {
+		c   int
+		exp string
+	}{
+		{0, "\x1b[30m"},
+		{1, "\x1b[34m"},
+		{2, "\x1b[32m"},
+		{3, "\x1b[36m"},
+		{4, "\x1b[31m"},
+		{6, "\x1b[33m"},
+		{9, "\x1b[94m"},
+		{12, "\x1b[91m"},
+	}
+	for _, test := range tests {
+		if s := ti.Printf(SetForeground, test.c); s != test.exp {
+			t.Errorf("color %d expected %q, got: %q", test.c, test.exp, s)
+		}
+	}
+}
+
+func TestPrintfTermcap(t *testing.T) {
+	// results of ncurses' tiparm with params 1 and 2
+	tests := []struct {
+		z, exp string
+	}{
+		{"%d;%d", "1;2"},
+		{"%d;%d;%d", "1;2;0"},
+		{"%i%d;%d", "3;2"},
+		{"%d%i;%d", "1;2"},
+		{"%i%d%i%d", "32"},
+		{"%i%i%d", "2"},
+		{"%i%i%p1%d", "2"},
+		{"%{7}%i%d;%d;%d", "7;3;2"},
+		{"%+%d", "3"},
+		{"%{5}%d;%d", "5;1"},
+		{"%{1}%{2}%d%d%d", "211"},
+		{"%Pa%d;%d", "2;0"},
+		{"%s%d", "2"},
+		{"%s%{1}%d", "1"},
+		{"%c%{1}%d", "\x011"},
+		{"%'a'%d%d", "971"},
+		{"%!%d", "0"},
+		{"%d;%p1%d", "0;1"},
+		{"%p0%d", "0"},
+	}
+	for _, test := range tests {
+		if s := new(StaticVars).Printf([]byte(test.z), 1, 2); s != test.exp {
+			t.Errorf("%q expected %q, got: %q", test.z, test.exp, s)
+		}
+	}
+}
+
+func TestPrintfFormat(t *testing.T) {
+	buf, err := ioutil.ReadFile(filepath.Join("testdata", "tparm.txt"))
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	for i, line := range strings.Split(strings.TrimSpace(string(buf)), "\n") {
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		f := strings.Split(line, "\t")
+		if len(f) != 3 {
+			t.Fatalf("line %d expected 3 fields, got: %q", i+1, line)
+		}
+		exp, err := hex.DecodeString(f[2])
+		if err != nil {
+			t.Fatalf("line %d expected no error, got: %v", i+1, err)
+		}
+		var v interface{} = f[1]
+		if n, err := strconv.Atoi(f[1]); err == nil {
+			v = n
+		}
+		if s := Printf([]byte(f[0]), v); s != string(exp) {
+			t.Errorf("line %d %q with %v expected %q, got: %q", i+1, f[0], v, exp, s)
+		}
+	}
+}
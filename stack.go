@@ -15,25 +15,25 @@ func (s *stack) pop() interface{} {
 	return v
 }
 
+// popInt pops an int. As with ncurses, bools and chars are numbers, and
+// anything else is 0.
 func (s *stack) popInt() int {
-	if i, ok := s.pop().(int); ok {
-		return i
+	switch v := s.pop().(type) {
+	case int:
+		return v
+	case byte:
+		return int(v)
+	case bool:
+		if v {
+			return 1
+		}
 	}
 	return 0
 }
 
+// popBool pops a bool, which is true for any non-zero number.
 func (s *stack) popBool() bool {
-	if b, ok := s.pop().(bool); ok {
-		return b
-	}
-	return false
-}
-
-func (s *stack) popByte() byte {
-	if b, ok := s.pop().(byte); ok {
-		return b
-	}
-	return 0
+	return s.popInt() != 0
 }
 
 func (s *stack) popString() string {
@@ -0,0 +1,142 @@
+package terminfo
+
+import (
+	"io"
+)
+
+// xterm status line sequences, used when a terminal is marked with the
+// extended XT bool but does not provide its own status line caps.
+var (
+	xtermToStatusLine   = []byte("\x1b]2;")
+	xtermFromStatusLine = []byte("\a")
+	xtermPushTitle      = []byte("\x1b[22;0t")
+	xtermPopTitle       = []byte("\x1b[23;0t")
+)
+
+// statusLine returns the sequences that move to and return from the status
+// line. The sequence moving to the status line is the extended TS string, then
+// the standard tsl string (when the terminal has the hs bool), and finally the
+// xterm sequence when the terminal has the extended XT bool. The sequence
+// returning from the status line is the fsl string, or the xterm sequence when
+// the terminal has the extended XT bool, so that the xterm title is always
+// terminated.
+func (ti *Terminfo) statusLine() ([]byte, []byte) {
+	var from []byte
+	switch {
+	case ti.StringsP.Has(FromStatusLine):
+		from = ti.Strings[FromStatusLine]
+	case ti.ExtBool("XT"):
+		from = xtermFromStatusLine
+	}
+	if s := ti.ExtString("TS"); s != nil {
+		return s, from
+	}
+	if ti.Has(HasStatusLine) && ti.StringsP.Has(ToStatusLine) {
+		return []byte(ti.Printf(ToStatusLine, 0)), from
+	}
+	if ti.ExtBool("XT") {
+		return xtermToStatusLine, from
+	}
+	return nil, nil
+}
+
+// HasStatusLine determines if the terminal has a status line (usually the
+// window title) that can be written to.
+func (ti *Terminfo) HasStatusLine() bool {
+	to, _ := ti.statusLine()
+	return to != nil
+}
+
+// HasTitleStack determines if the terminal supports saving and restoring the
+// window title.
+func (ti *Terminfo) HasTitleStack() bool {
+	return ti.ExtBool("XT")
+}
+
+// Title returns the string that sets the status line to s, or an empty string
+// if the terminal does not have a status line.
+//
+// Control characters are removed from s unless the terminal has the eslok
+// bool, and s is truncated to wsl characters when the terminal defines the
+// wsl num.
+func (ti *Terminfo) Title(s string) string {
+	to, from := ti.statusLine()
+	if to == nil {
+		return ""
+	}
+
+	z := []byte(s)
+	if !ti.Has(StatusLineEscOk) {
+		z = stripControl(z)
+	}
+	if n := ti.Num(WidthStatusLine); n > 0 {
+		z = truncateRunes(z, n)
+	}
+
+	return string(to) + string(z) + string(from)
+}
+
+// SetTitle writes the string that sets the status line to s to writer w.
+func (ti *Terminfo) SetTitle(w io.Writer, s string) error {
+	if !ti.HasStatusLine() {
+		return ErrNoStatusLine
+	}
+	_, err := io.WriteString(w, ti.Title(s))
+	return err
+}
+
+// ClearStatusLine writes the string that clears the status line to writer w,
+// using the dsl string when present.
+func (ti *Terminfo) ClearStatusLine(w io.Writer) error {
+	if !ti.HasStatusLine() {
+		return ErrNoStatusLine
+	}
+	if ti.StringsP.Has(DisStatusLine) {
+		_, err := w.Write(ti.Strings[DisStatusLine])
+		return err
+	}
+	return ti.SetTitle(w, "")
+}
+
+// PushTitle writes the string that saves the current window title on the
+// terminal's title stack to writer w.
+func (ti *Terminfo) PushTitle(w io.Writer) error {
+	if !ti.HasTitleStack() {
+		return ErrNoTitleStack
+	}
+	_, err := w.Write(xtermPushTitle)
+	return err
+}
+
+// PopTitle writes the string that restores the window title last saved by
+// PushTitle to writer w.
+func (ti *Terminfo) PopTitle(w io.Writer) error {
+	if !ti.HasTitleStack() {
+		return ErrNoTitleStack
+	}
+	_, err := w.Write(xtermPopTitle)
+	return err
+}
+
+// truncateRunes returns z truncated to at most n runes.
+func truncateRunes(z []byte, n int) []byte {
+	for i := range string(z) {
+		if n == 0 {
+			return z[:i]
+		}
+		n--
+	}
+	return z
+}
+
+// stripControl returns z with all control characters removed.
+func stripControl(z []byte) []byte {
+	r := make([]byte, 0, len(z))
+	for _, c := range z {
+		if c < ' ' || c == 0x7f {
+			continue
+		}
+		r = append(r, c)
+	}
+	return r
+}
@@ -0,0 +1,75 @@
+package terminfo
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestTitle(t *testing.T) {
+	sl := withCaps(&Terminfo{}, []int{HasStatusLine}, nil, map[int]string{
+		ToStatusLine:   "\x1b]0;",
+		FromStatusLine: "\a",
+		DisStatusLine:  "\x1b]0;\a",
+	})
+	ts := withCaps(&Terminfo{
+		ExtBools:       map[int]bool{0: true},
+		ExtBoolNames:   map[int][]byte{0: []byte("XT")},
+		ExtStrings:     map[int][]byte{0: []byte("\x1b]2;")},
+		ExtStringNames: map[int][]byte{0: []byte("TS")},
+	}, []int{StatusLineEscOk}, map[int]int{WidthStatusLine: 5}, nil)
+	nofsl := withCaps(&Terminfo{}, []int{HasStatusLine}, map[int]int{WidthStatusLine: 3}, map[int]string{
+		ToStatusLine: "\x1b_",
+	})
+	xt := &Terminfo{
+		ExtBools:     map[int]bool{0: true},
+		ExtBoolNames: map[int][]byte{0: []byte("XT")},
+	}
+	// strings that are not in the present set are not used
+	unset := withCaps(&Terminfo{}, []int{HasStatusLine}, nil, map[int]string{
+		ToStatusLine:   "\x1b]0;",
+		FromStatusLine: "\a",
+		DisStatusLine:  "\x1b]0;\a",
+	})
+	unset.StringsP.Unset(FromStatusLine)
+	unset.StringsP.Unset(DisStatusLine)
+	none := &Terminfo{}
+	tests := []struct {
+		ti    *Terminfo
+		s     string
+		exp   string
+		clear string
+		stack bool
+	}{
+		{sl, "a\x1b\tb", "\x1b]0;ab\a", "\x1b]0;\a", false},
+		{ts, "a\x1b\tbcdef", "\x1b]2;a\x1b\tbc\a", "\x1b]2;\a", true},
+		{nofsl, "日本語です", "\x1b_日本語", "\x1b_", false},
+		{xt, "title", "\x1b]2;title\a", "\x1b]2;\a", true},
+		{unset, "title", "\x1b]0;title", "\x1b]0;", false},
+		{none, "title", "", "", false},
+	}
+	for i, test := range tests {
+		if s := test.ti.Title(test.s); s != test.exp {
+			t.Errorf("test %d expected %q, got: %q", i, test.exp, s)
+		}
+		buf := new(bytes.Buffer)
+		err := test.ti.SetTitle(buf, test.s)
+		switch {
+		case test.exp == "" && err != ErrNoStatusLine:
+			t.Errorf("test %d expected ErrNoStatusLine, got: %v", i, err)
+		case test.exp != "" && err != nil:
+			t.Errorf("test %d expected no error, got: %v", i, err)
+		case buf.String() != test.exp:
+			t.Errorf("test %d expected %q, got: %q", i, test.exp, buf.String())
+		}
+		buf.Reset()
+		if err = test.ti.ClearStatusLine(buf); test.clear != "" && err != nil {
+			t.Errorf("test %d expected no error, got: %v", i, err)
+		} else if buf.String() != test.clear {
+			t.Errorf("test %d expected clear %q, got: %q", i, test.clear, buf.String())
+		}
+		buf.Reset()
+		if err = test.ti.PushTitle(buf); test.stack != (err == nil) {
+			t.Errorf("test %d expected title stack %t, got: %v", i, test.stack, err)
+		}
+	}
+}
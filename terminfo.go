@@ -4,9 +4,11 @@ package terminfo
 import (
 	"io"
 	"io/ioutil"
+	"os"
 	"path"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // Error is a terminfo error.
@@ -50,9 +52,48 @@ const (
 
 	// ErrInvalidTermProgramVersion is the invalid TERM_PROGRAM_VERSION error.
 	ErrInvalidTermProgramVersion Error = "invalid TERM_PROGRAM_VERSION"
+
+	// ErrNoStatusLine is the no status line error.
+	ErrNoStatusLine Error = "no status line"
+
+	// ErrNoTitleStack is the no title stack error.
+	ErrNoTitleStack Error = "no title stack"
+
+	// ErrNoCursorStyle is the no cursor style error.
+	ErrNoCursorStyle Error = "no cursor style"
+
+	// ErrNoCursorColor is the no cursor color error.
+	ErrNoCursorColor Error = "no cursor color"
+
+	// ErrNoClipboard is the no clipboard error.
+	ErrNoClipboard Error = "no clipboard"
+
+	// ErrInvalidClipboardReply is the invalid clipboard reply error.
+	ErrInvalidClipboardReply Error = "invalid clipboard reply"
+
+	// ErrNoBracketedPaste is the no bracketed paste error.
+	ErrNoBracketedPaste Error = "no bracketed paste"
+
+	// ErrNoFocusEvents is the no focus events error.
+	ErrNoFocusEvents Error = "no focus events"
 )
 
 // Terminfo describes a terminal's capabilities.
+//
+// The standard caps are arrays indexed by the cap constants, and a cap is only
+// defined when it is in the present set (BoolsP, NumsP or StringsP), as absent
+// caps have zero values. Code using the former map fields migrates as follows:
+//
+//	_, ok := ti.Nums[i]           ok := ti.NumsP.Has(i)
+//	ti.Strings[i] != nil          ti.StringsP.Has(i)
+//	ti.StringsM[i]                ti.StringsM.Has(i)
+//	ti.Strings[i] = s             ti.Strings[i] = s; ti.StringsP.Set(i)
+//	delete(ti.Strings, i)         ti.StringsP.Unset(i)
+//	for i, s := range ti.Strings  for _, i := range ti.StringsP.Indexes()
+//	len(ti.Strings)               ti.StringsP.Len()
+//
+// Reading a value (ti.Bools[i], ti.Nums[i] or ti.Strings[i]) is unchanged, and
+// the Has, Num and Printf methods are the same.
 type Terminfo struct {
 	// File is the original source file.
 	File string
@@ -61,48 +102,76 @@ type Terminfo struct {
 	Names []string
 
 	// Bools are the bool capabilities.
-	Bools map[int]bool
+	Bools [CapCountBool]bool
 
-	// BoolsM are the missing bool capabilities.
-	BoolsM map[int]bool
+	// BoolsP are the present bool capabilities.
+	BoolsP CapSet
 
-	// Nums are the num capabilities.
-	Nums map[int]int
+	// BoolsM are the cancelled bool capabilities.
+	BoolsM CapSet
 
-	// NumsM are the missing num capabilities.
-	NumsM map[int]bool
+	// Nums are the num capabilities. Nums that are not present are 0.
+	Nums [CapCountNum]int
+
+	// NumsP are the present num capabilities.
+	NumsP CapSet
+
+	// NumsM are the cancelled num capabilities.
+	NumsM CapSet
 
 	// Strings are the string capabilities.
-	Strings map[int][]byte
+	Strings [CapCountString][]byte
 
-	// StringsM are the missing string capabilities.
-	StringsM map[int]bool
+	// StringsP are the present string capabilities.
+	StringsP CapSet
+
+	// StringsM are the cancelled string capabilities.
+	StringsM CapSet
 
 	// ExtBools are the extended bool capabilities.
 	ExtBools map[int]bool
 
+	// ExtBoolsM are the cancelled extended bool capabilities.
+	ExtBoolsM map[int]bool
+
 	// ExtBoolsNames is the map of extended bool capabilities to their index.
 	ExtBoolNames map[int][]byte
 
-	// ExtNums are the extended num capabilities.
+	// ExtNums are the present extended num capabilities.
 	ExtNums map[int]int
 
+	// ExtNumsM are the cancelled extended num capabilities.
+	ExtNumsM map[int]bool
+
 	// ExtNumsNames is the map of extended num capabilities to their index.
 	ExtNumNames map[int][]byte
 
 	// ExtStrings are the extended string capabilities.
 	ExtStrings map[int][]byte
 
+	// ExtStringsM are the cancelled extended string capabilities.
+	ExtStringsM map[int]bool
+
 	// ExtStringsNames is the map of extended string capabilities to their index.
 	ExtStringNames map[int][]byte
+
+	// extIndex is the index of extended capability names.
+	extIndex *extIndex
+
+	// static are the static variables of the parameterized strings.
+	static *StaticVars
 }
 
 // Decode decodes the terminfo data contained in buf.
+//
+// As with ncurses, buf can be at most 32768 bytes, and in the legacy number
+// format the header, names and standard capabilities can be at most 4096
+// bytes.
 func Decode(buf []byte) (*Terminfo, error) {
 	var err error
 
 	// check max file length
-	if len(buf) >= maxFileLength {
+	if len(buf) > maxFileLength {
 		return nil, ErrInvalidFileSize
 	}
 
@@ -133,6 +202,11 @@ func Decode(buf []byte) (*Terminfo, error) {
 		return nil, ErrInvalidHeader
 	}
 
+	// check max length of the standard capabilities
+	if headerLength+capLength(h) > maxLength(h[fieldMagic]) {
+		return nil, ErrInvalidFileSize
+	}
+
 	// check remaining length
 	if d.len-d.pos < capLength(h) {
 		return nil, ErrUnexpectedFileEnd
@@ -151,34 +225,26 @@ func Decode(buf []byte) (*Terminfo, error) {
 	}
 	names = names[:i]
 
+	ti := &Terminfo{
+		Names:  strings.Split(string(names), "|"),
+		static: new(StaticVars),
+	}
+
 	// read bool caps
-	bools, boolsM, err := d.readBools(h[fieldBoolCount])
-	if err != nil {
+	if err = d.readBools(ti, h[fieldBoolCount]); err != nil {
 		return nil, err
 	}
 
 	// read num caps
-	nums, numsM, err := d.readNums(h[fieldNumCount], numWidth)
-	if err != nil {
+	if err = d.readNums(ti, h[fieldNumCount], numWidth); err != nil {
 		return nil, err
 	}
 
 	// read string caps
-	strs, strsM, err := d.readStrings(h[fieldStringCount], h[fieldTableSize])
-	if err != nil {
+	if err = d.readStrings(ti, h[fieldStringCount], h[fieldTableSize]); err != nil {
 		return nil, err
 	}
 
-	ti := &Terminfo{
-		Names:    strings.Split(string(names), "|"),
-		Bools:    bools,
-		BoolsM:   boolsM,
-		Nums:     nums,
-		NumsM:    numsM,
-		Strings:  strs,
-		StringsM: strsM,
-	}
-
 	// at the end of file, so no extended caps
 	if d.pos >= d.len {
 		return ti, nil
@@ -201,19 +267,19 @@ func Decode(buf []byte) (*Terminfo, error) {
 	}
 
 	// read extended bool caps
-	ti.ExtBools, _, err = d.readBools(eh[fieldExtBoolCount])
+	ti.ExtBools, ti.ExtBoolsM, err = d.readExtBools(eh[fieldExtBoolCount])
 	if err != nil {
 		return nil, err
 	}
 
 	// read extended num caps
-	ti.ExtNums, _, err = d.readNums(eh[fieldExtNumCount], numWidth)
+	ti.ExtNums, ti.ExtNumsM, err = d.readExtNums(eh[fieldExtNumCount], numWidth)
 	if err != nil {
 		return nil, err
 	}
 
 	// read extended string data table indexes
-	extIndexes, err := d.readInts(eh[fieldExtOffsetCount], 16)
+	extIndexes, err := d.readInts(extOffsetCount(eh), 16)
 	if err != nil {
 		return nil, err
 	}
@@ -235,6 +301,7 @@ func Decode(buf []byte) (*Terminfo, error) {
 	if err != nil {
 		return nil, err
 	}
+	ti.ExtStringsM = cancelledStrings(extIndexes, eh[fieldExtStringCount])
 	extIndexes, extData = extIndexes[eh[fieldExtStringCount]:], extData[last:]
 
 	// read extended bool names
@@ -258,54 +325,71 @@ func Decode(buf []byte) (*Terminfo, error) {
 	}
 	//extIndexes = extIndexes[eh[fieldExtStringCount]:]
 
+	ti.indexExt()
+
 	return ti, nil
 }
 
-// Open reads the terminfo file name from the specified directory dir.
+// Open reads the terminfo file name from the specified directory dir. Unlike
+// Load, the terminfo is not cached.
+//
+// When the file cannot be read or decoded, the returned error is a *LoadError
+// wrapping either ErrFileNotFound or the decode error.
 func Open(dir, name string) (*Terminfo, error) {
-	var err error
-	var buf []byte
-	var filename string
+	if name == "" {
+		return nil, ErrEmptyTermName
+	}
+	ti, _, attempts, err := open(dir, name)
+	if err != nil {
+		return nil, &LoadError{Name: name, Dirs: []string{dir}, Attempts: attempts, Err: err}
+	}
+	return ti, nil
+}
+
+// open reads and decodes the file for name in the database directory dir,
+// returning the modification time of the file and the files that were tried
+// and could not be used.
+func open(dir, name string) (*Terminfo, time.Time, []LoadAttempt, error) {
+	var attempts []LoadAttempt
 	for _, f := range []string{
 		path.Join(dir, name[0:1], name),
 		path.Join(dir, strconv.FormatUint(uint64(name[0]), 16), name),
 	} {
-		buf, err = ioutil.ReadFile(f)
-		if err == nil {
-			filename = f
-			break
+		// stat before reading, so that a file modified while being read is
+		// read again when checking modification times
+		fi, err := os.Stat(f)
+		if err != nil {
+			attempts = append(attempts, LoadAttempt{Dir: dir, File: f, Err: err})
+			continue
+		}
+		buf, err := ioutil.ReadFile(f)
+		if err != nil {
+			attempts = append(attempts, LoadAttempt{Dir: dir, File: f, Err: err})
+			continue
 		}
-	}
-	if buf == nil {
-		return nil, ErrFileNotFound
-	}
 
-	// decode
-	ti, err := Decode(buf)
-	if err != nil {
-		return nil, err
-	}
+		// decode
+		ti, err := Decode(buf)
+		if err != nil {
+			attempts = append(attempts, LoadAttempt{Dir: dir, File: f, Err: err})
+			return nil, time.Time{}, attempts, err
+		}
 
-	// save original file name
-	ti.File = filename
+		// save original file name
+		ti.File = f
 
-	// add to cache
-	termCache.Lock()
-	for _, n := range ti.Names {
-		termCache.db[n] = ti
+		return ti, fi.ModTime(), attempts, nil
 	}
-	termCache.Unlock()
-
-	return ti, nil
+	return nil, time.Time{}, attempts, ErrFileNotFound
 }
 
 // boolCaps returns all bool and extended capabilities using f to format the
 // index key.
 func (ti *Terminfo) boolCaps(f func(int) string, extended bool) map[string]bool {
-	m := make(map[string]bool, len(ti.Bools)+len(ti.ExtBools))
+	m := make(map[string]bool, ti.BoolsP.Len()+len(ti.ExtBools))
 	if !extended {
-		for k, v := range ti.Bools {
-			m[f(k)] = v
+		for _, k := range ti.BoolsP.Indexes() {
+			m[f(k)] = ti.Bools[k]
 		}
 	} else {
 		for k, v := range ti.ExtBools {
@@ -340,10 +424,10 @@ func (ti *Terminfo) ExtBoolCapsShort() map[string]bool {
 // numCaps returns all num and extended capabilities using f to format the
 // index key.
 func (ti *Terminfo) numCaps(f func(int) string, extended bool) map[string]int {
-	m := make(map[string]int, len(ti.Nums)+len(ti.ExtNums))
+	m := make(map[string]int, ti.NumsP.Len()+len(ti.ExtNums))
 	if !extended {
-		for k, v := range ti.Nums {
-			m[f(k)] = v
+		for _, k := range ti.NumsP.Indexes() {
+			m[f(k)] = ti.Nums[k]
 		}
 	} else {
 		for k, v := range ti.ExtNums {
@@ -378,10 +462,10 @@ func (ti *Terminfo) ExtNumCapsShort() map[string]int {
 // stringCaps returns all string and extended capabilities using f to format the
 // index key.
 func (ti *Terminfo) stringCaps(f func(int) string, extended bool) map[string][]byte {
-	m := make(map[string][]byte, len(ti.Strings)+len(ti.ExtStrings))
+	m := make(map[string][]byte, ti.StringsP.Len()+len(ti.ExtStrings))
 	if !extended {
-		for k, v := range ti.Strings {
-			m[f(k)] = v
+		for _, k := range ti.StringsP.Indexes() {
+			m[f(k)] = ti.Strings[k]
 		}
 	} else {
 		for k, v := range ti.ExtStrings {
@@ -415,32 +499,88 @@ func (ti *Terminfo) ExtStringCapsShort() map[string][]byte {
 
 // Has determines if the bool cap i is present.
 func (ti *Terminfo) Has(i int) bool {
-	return ti.Bools[i]
+	return ti.BoolsP.Has(i)
 }
 
 // Num returns the num cap i, or -1 if not present.
 func (ti *Terminfo) Num(i int) int {
-	n, ok := ti.Nums[i]
-	if !ok {
+	if !ti.NumsP.Has(i) {
 		return -1
 	}
-	return n
+	return ti.Nums[i]
+}
+
+// str returns the string cap i, or nil if not present.
+func (ti *Terminfo) str(i int) []byte {
+	if !ti.StringsP.Has(i) {
+		return nil
+	}
+	return ti.Strings[i]
+}
+
+// State returns the state of the cap i of kind. For extended kinds, i is the
+// index of the capability in the extended capabilities of the same kind.
+func (ti *Terminfo) State(kind CapKind, i int) CapState {
+	var present, cancelled bool
+	switch kind {
+	case CapKindBool:
+		present, cancelled = ti.BoolsP.Has(i), ti.BoolsM.Has(i)
+	case CapKindNum:
+		present, cancelled = ti.NumsP.Has(i), ti.NumsM.Has(i)
+	case CapKindString:
+		present, cancelled = ti.StringsP.Has(i), ti.StringsM.Has(i)
+	case CapKindExtBool:
+		present, cancelled = ti.ExtBools[i], ti.ExtBoolsM[i]
+	case CapKindExtNum:
+		_, present = ti.ExtNums[i]
+		cancelled = ti.ExtNumsM[i]
+	case CapKindExtString:
+		_, present = ti.ExtStrings[i]
+		cancelled = ti.ExtStringsM[i]
+	}
+	switch {
+	case present:
+		return CapPresent
+	case cancelled:
+		return CapCancelled
+	}
+	return CapAbsent
+}
+
+// CapState returns the state of the capability with the long, short,
+// termcap or extended name.
+func (ti *Terminfo) CapState(name string) CapState {
+	kind, i, ok := ti.LookupCap(name)
+	if !ok {
+		return CapAbsent
+	}
+	return ti.State(kind, i)
+}
+
+// StaticVars returns the static variables used when formatting the
+// terminfo's string caps. Each decoded terminfo has its own static variables,
+// otherwise the static variables of Printf are used.
+func (ti *Terminfo) StaticVars() *StaticVars {
+	if ti.static == nil {
+		return &staticVars
+	}
+	return ti.static
 }
 
 // Printf formats the string cap i, interpolating parameters v.
 func (ti *Terminfo) Printf(i int, v ...interface{}) string {
-	return Printf(ti.Strings[i], v...)
+	return ti.StaticVars().Printf(ti.str(i), v...)
 }
 
 // Fprintf prints the string cap i to writer w, interpolating parameters v.
 func (ti *Terminfo) Fprintf(w io.Writer, i int, v ...interface{}) {
-	Fprintf(w, ti.Strings[i], v...)
+	ti.StaticVars().Fprintf(w, ti.str(i), v...)
 }
 
 // Color takes a foreground and background color and returns string that sets
 // them for this terminal.
 func (ti *Terminfo) Colorf(fg, bg int, str string) string {
-	maxColors := int(ti.Nums[MaxColors])
+	maxColors := ti.Num(MaxColors)
 
 	// map bright colors to lower versions if the color table only holds 8.
 	if maxColors == 8 {
@@ -465,7 +605,7 @@ func (ti *Terminfo) Colorf(fg, bg int, str string) string {
 // Goto returns a string suitable for addressing the cursor at the given
 // row and column. The origin 0, 0 is in the upper left corner of the screen.
 func (ti *Terminfo) Goto(row, col int) string {
-	return Printf(ti.Strings[CursorAddress], row, col)
+	return ti.Printf(CursorAddress, row, col)
 }
 
 // Puts emits the string to the writer, but expands inline padding indications
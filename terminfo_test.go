@@ -2,7 +2,10 @@ package terminfo
 
 import (
 	"errors"
+	"flag"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"os/exec"
 	"path/filepath"
 	"reflect"
@@ -39,6 +42,12 @@ func TestOpen(t *testing.T) {
 	}
 }
 
+func TestOpenEmptyName(t *testing.T) {
+	if _, err := Open("/lib/terminfo", ""); err != ErrEmptyTermName {
+		t.Errorf("expected ErrEmptyTermName, got: %v", err)
+	}
+}
+
 var infocmpMap = struct {
 	ic map[string]*infocmp
 	sync.RWMutex
@@ -54,6 +63,7 @@ func TestValues(t *testing.T) {
 	}
 
 	for term, filename := range terms(t) {
+		term, filename := term, filename
 		t.Run(strings.TrimPrefix(filename, "/"), func(t *testing.T) {
 			t.Parallel()
 
@@ -62,7 +72,7 @@ func TestValues(t *testing.T) {
 			infocmpMap.RUnlock()
 
 			// load
-			ti, err := Load(term)
+			ti, err := Open(filepath.Dir(filepath.Dir(filename)), term)
 			if err != nil {
 				t.Fatalf("term %s expected no error, got: %v", term, err)
 			}
@@ -75,7 +85,7 @@ func TestValues(t *testing.T) {
 			// check bool caps
 			for i, v := range ic.boolCaps {
 				if v == nil {
-					if _, ok := ti.BoolsM[i]; !ok {
+					if !ti.BoolsM.Has(i) {
 						t.Errorf("term %s expected bool cap %d (%s) to be missing", term, i, BoolCapName(i))
 					}
 				} else if v.(bool) != ti.Bools[i] {
@@ -108,7 +118,7 @@ func TestValues(t *testing.T) {
 			// check num caps
 			for i, v := range ic.numCaps {
 				if v == nil {
-					if _, ok := ti.NumsM[i]; !ok {
+					if !ti.NumsM.Has(i) {
 						//t.Errorf("term %s expected num cap %d (%s) to be missing", term, i, NumCapName(i))
 					}
 				} else if v.(int) != ti.Nums[i] {
@@ -141,7 +151,7 @@ func TestValues(t *testing.T) {
 			// check string caps
 			for i, v := range ic.stringCaps {
 				if v == nil {
-					if _, ok := ti.StringsM[i]; !ok {
+					if !ti.StringsM.Has(i) {
 						//t.Errorf("term %s expected string cap %d (%s) to be missing", term, i, StringCapName(i))
 					}
 				} else if v.(string) != string(ti.Strings[i]) {
@@ -205,14 +215,16 @@ func infocmpLoader(wg *sync.WaitGroup, t *testing.T, id int, terms <-chan string
 	for term := range terms {
 		ic, err := getInfocmpData(t, term)
 		if err != nil {
-			res <- fmt.Errorf("loader %d: %v", id, err)
-			return
+			select {
+			case res <- fmt.Errorf("loader %d: %v", id, err):
+			default:
+			}
+		} else {
+			infocmpMap.Lock()
+			infocmpMap.ic[term] = ic
+			infocmpMap.Unlock()
 		}
 
-		infocmpMap.Lock()
-		infocmpMap.ic[term] = ic
-		infocmpMap.Unlock()
-
 		wg.Done()
 	}
 }
@@ -248,13 +260,25 @@ var (
 )
 
 func getInfocmpData(t *testing.T, term string) (*infocmp, error) {
-	c := exec.Command("/usr/bin/infocmp", "-E", "-x")
-	c.Env = []string{"TERM=" + term}
+	file := filepath.Join("testdata", "infocmp", term+".txt")
+	if *updateInfocmp {
+		c := exec.Command("infocmp", "-E", "-x", "-A", termDirs()[0], term)
+		buf, err := c.CombinedOutput()
+		if err != nil {
+			t.Logf("shell error (TERM=%s):\n%s\n", term, string(buf))
+			return nil, err
+		}
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			return nil, err
+		}
+		if err := ioutil.WriteFile(file, buf, 0644); err != nil {
+			return nil, err
+		}
+	}
 
-	buf, err := c.CombinedOutput()
+	buf, err := ioutil.ReadFile(file)
 	if err != nil {
-		t.Logf("shell error (TERM=%s):\n%s\n", term, string(buf))
-		return nil, err
+		return nil, fmt.Errorf("could not read infocmp output (run with -infocmp to create it): %v", err)
 	}
 
 	// read static strings
@@ -361,7 +385,7 @@ func processSect(buf []byte, caps map[string]string, xx, yy map[int]interface{},
 
 		case numRE.MatchString(s[2]):
 			var j int64
-			j, err = strconv.ParseInt(s[2], 10, 16)
+			j, err = strconv.ParseInt(s[2], 10, 32)
 			if err != nil {
 				return fmt.Errorf("line %d could not parse: %v", i, err)
 			}
@@ -369,11 +393,7 @@ func processSect(buf []byte, caps map[string]string, xx, yy map[int]interface{},
 
 		case absCanRE.MatchString(s[2]):
 			if !ok { // absent/canceled extended cap
-				if strings.HasSuffix(s[2], "NUMERIC") {
-					v = -1
-				} else {
-					skip = true
-				}
+				skip = true
 			}
 
 		default:
@@ -390,3 +410,226 @@ func processSect(buf []byte, caps map[string]string, xx, yy map[int]interface{},
 
 	return nil
 }
+
+var benchDir = flag.String("benchdir", "", "run the benchmarks against the entries in a terminfo directory (e.g. /usr/share/terminfo) instead of testdata")
+
+// loadBenchFiles returns the entries that decode in the -benchdir directory,
+// or in testdata.
+func loadBenchFiles(b *testing.B) [][]byte {
+	files := terms(b)
+	if *benchDir != "" {
+		files = make(map[string]string)
+		err := filepath.Walk(*benchDir, func(file string, fi os.FileInfo, err error) error {
+			if err == nil && fi.Mode().IsRegular() {
+				files[file] = file
+			}
+			return err
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	var bufs [][]byte
+	for _, file := range files {
+		buf, err := ioutil.ReadFile(file)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if _, err := Decode(buf); err != nil {
+			continue
+		}
+		bufs = append(bufs, buf)
+	}
+	return bufs
+}
+
+func BenchmarkDecode(b *testing.B) {
+	bufs := loadBenchFiles(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, buf := range bufs {
+			if _, err := Decode(buf); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkLookup(b *testing.B) {
+	var tis []*Terminfo
+	for _, buf := range loadBenchFiles(b) {
+		ti, _ := Decode(buf)
+		tis = append(tis, ti)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, ti := range tis {
+			for j := 0; j < CapCountBool; j++ {
+				_ = ti.Has(j)
+			}
+			for j := 0; j < CapCountNum; j++ {
+				_ = ti.Num(j)
+			}
+		}
+	}
+}
+
+// stateEntry is a compiled entry with present, cancelled and absent caps of
+// every kind.
+var stateEntry = []byte("" +
+	// header: magic, name size, bools, nums, strings, table size
+	"\x1a\x01\x07\x00\x02\x00\x02\x00\x02\x00\x04\x00" +
+	// names
+	"x|test\x00" +
+	// bools (bw present, am cancelled) and alignment
+	"\x01\xfe\x00" +
+	// nums (cols cancelled, it present)
+	"\xfe\xff\x50\x00" +
+	// strings (cbt cancelled, bel present) and string table
+	"\xfe\xff\x00\x00" + "\x1b[H\x00" +
+	// extended header: bools, nums, strings, entries in use, table size
+	"\x02\x00\x02\x00\x02\x00\x07\x00\x15\x00" +
+	// extended bools (Ba present, Bb cancelled)
+	"\x01\xfe" +
+	// extended nums (Na cancelled, Nb present)
+	"\xfe\xff\x03\x00" +
+	// extended string offsets (Sc cancelled, Sd present) and name offsets
+	"\xfe\xff\x00\x00" + "\x00\x00\x03\x00\x06\x00\x09\x00\x0c\x00\x0f\x00" +
+	// extended string table
+	"xy\x00" + "Ba\x00Bb\x00Na\x00Nb\x00Sc\x00Sd\x00")
+
+func TestState(t *testing.T) {
+	ti, err := Decode(stateEntry)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	tests := []struct {
+		name string
+		exp  CapState
+	}{
+		{"bw", CapPresent},
+		{"am", CapCancelled},
+		{"xsb", CapAbsent},
+		{"cols", CapCancelled},
+		{"it", CapPresent},
+		{"lines", CapAbsent},
+		{"cbt", CapCancelled},
+		{"bel", CapPresent},
+		{"cr", CapAbsent},
+		{"Ba", CapPresent},
+		{"Bb", CapCancelled},
+		{"Na", CapCancelled},
+		{"Nb", CapPresent},
+		{"Sc", CapCancelled},
+		{"Sd", CapPresent},
+		{"missing", CapAbsent},
+	}
+	for _, test := range tests {
+		if s := ti.CapState(test.name); s != test.exp {
+			t.Errorf("%s expected %s, got: %s", test.name, test.exp, s)
+		}
+	}
+	if ti.Has(AutoRightMargin) {
+		t.Errorf("cancelled bool should not be set")
+	}
+	if ti.Nums[Columns] != 0 || ti.Num(Columns) != -1 || ti.Num(InitTabs) != 80 {
+		t.Errorf("expected cancelled num to be 0 and -1, got: %d %d", ti.Nums[Columns], ti.Num(Columns))
+	}
+	if ti.ExtNum("Na") != -1 || ti.ExtNum("Nb") != 3 {
+		t.Errorf("expected extended nums -1 and 3, got: %d %d", ti.ExtNum("Na"), ti.ExtNum("Nb"))
+	}
+	if s := string(ti.ExtString("Sd")); s != "xy" {
+		t.Errorf("expected extended string %q, got: %q", "xy", s)
+	}
+}
+
+// sizedEntry returns a compiled entry with file magic m and a standard
+// string table of sz bytes, padded with extended string caps to exactly n
+// bytes.
+func sizedEntry(m, n, sz int) []byte {
+	le := func(v int) string { return string([]byte{byte(v), byte(v >> 8)}) }
+	names := "big|oversized entry\x00"
+	head := le(m) + le(len(names)) + le(0) + le(0) + le(0) + le(sz) + names
+	head += strings.Repeat("\x00", len(head)%2) + strings.Repeat("\x00", sz+sz%2)
+
+	// each cap takes 4 bytes of offsets, 2 bytes of value (including the
+	// null), and 5 bytes of name (including the null)
+	caps := (n - len(head) - 10) / 11
+	value := strings.Repeat("v", n-len(head)-10-caps*11+1)
+	var offsets, values, capNames string
+	for i := 0; i < caps; i++ {
+		v := "x"
+		if i == 0 {
+			v = value
+		}
+		offsets += le(len(values))
+		values += v + "\x00"
+	}
+	for i := 0; i < caps; i++ {
+		offsets += le(len(capNames))
+		capNames += fmt.Sprintf("%04x\x00", i)
+	}
+	table := values + capNames
+	return []byte(head + le(0) + le(0) + le(caps) + le(2*caps) + le(len(table)) + offsets + table)
+}
+
+func TestDecodeSize(t *testing.T) {
+	tests := []struct {
+		m, n, sz int
+		err      error
+	}{
+		{magic, 1000, 0, nil},
+		{magic, 4096, 0, nil},
+		{magic, 4097, 0, nil},
+		{magic, 8192, 4000, nil},
+		{magic, 8192, 4064, nil},
+		{magic, 8192, 4065, ErrInvalidFileSize},
+		{magic, 32768, 0, nil},
+		{magic, 32769, 0, ErrInvalidFileSize},
+		{magicExtended, 4097, 0, nil},
+		{magicExtended, 20000, 8000, nil},
+		{magicExtended, 32768, 0, nil},
+		{magicExtended, 32769, 0, ErrInvalidFileSize},
+	}
+	for i, test := range tests {
+		buf := sizedEntry(test.m, test.n, test.sz)
+		if len(buf) != test.n {
+			t.Fatalf("test %d expected entry of %d bytes, got: %d", i, test.n, len(buf))
+		}
+		ti, err := Decode(buf)
+		switch {
+		case err != test.err:
+			t.Errorf("test %d expected error %v, got: %v", i, test.err, err)
+		case err == nil && (len(ti.ExtStrings) != (test.n-42-test.sz)/11 || string(ti.ExtString("0001")) != "x"):
+			t.Errorf("test %d expected %d extended strings, got: %d", i, (test.n-42-test.sz)/11, len(ti.ExtStrings))
+		}
+	}
+}
+
+func TestDecodeInvalidOffsets(t *testing.T) {
+	tests := []struct {
+		off int
+		buf string
+		err error
+	}{
+		// negative name size
+		{2, "\xff\xff", ErrInvalidHeader},
+		// negative table size
+		{10, "\xf0\xff", ErrInvalidHeader},
+		// string offset past the string table
+		{28, "\x10\x00", ErrInvalidStringTable},
+		// negative extended string count
+		{38, "\xfe\xff", ErrInvalidExtendedHeader},
+		// extended string offset past the string table
+		{52, "\x40\x00", ErrInvalidStringTable},
+	}
+	for i, test := range tests {
+		buf := append([]byte(nil), stateEntry...)
+		copy(buf[test.off:], test.buf)
+		if _, err := Decode(buf); err != test.err {
+			t.Errorf("test %d expected %v, got: %v", i, test.err, err)
+		}
+	}
+}
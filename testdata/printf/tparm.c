@@ -0,0 +1,114 @@
+/*
+ * tparm is the harness used to generate the Printf golden files from the
+ * output of ncurses' tiparm. Build it with:
+ *
+ *   cc -o tparm tparm.c $(pkg-config --cflags --libs tinfo)
+ *
+ * and regenerate the golden files with:
+ *
+ *   go test -run TestPrintfGolden -tparm ./testdata/printf/tparm
+ *
+ * Each input line is "hexfmt<TAB>nums<TAB>strs", where nums and strs are 9
+ * space separated numbers and strings. Params that ncurses analyzes as
+ * strings are passed from strs, and all others from nums. Each output line is
+ * "params<TAB>hexout", where params are the params passed to tiparm (numbers,
+ * or quoted strings), or "NULL" when tiparm fails.
+ */
+#include <stdio.h>
+#include <stdlib.h>
+#include <string.h>
+#include <curses.h>
+#include <term.h>
+
+#define MAX_PARAMS 9
+#define FILLER 4096
+
+static char filler[2][FILLER + 1];
+
+static void unhex(const char *s, char *out) {
+	int n = 0;
+	for (; s[0] && s[1]; s += 2) {
+		unsigned v;
+		sscanf(s, "%2x", &v);
+		out[n++] = (char)v;
+	}
+	out[n] = 0;
+}
+
+/* call runs tiparm_s with n params, passing the params in mask from strs and
+   the others from nums, first resetting the static variables and filling the
+   result buffer with filler f, so the output after a NUL can be recovered by
+   comparing the results of both fillers */
+static char *call(const char *fmt, int f, int n, int mask, long *nums, char **strs) {
+	char *p[MAX_PARAMS];
+	tiparm("%{0}%PA%{0}%PB%{0}%PC%{0}%PD%{0}%PE%{0}%PF%{0}%PG%{0}%PH%{0}%PI"
+		"%{0}%PJ%{0}%PK%{0}%PL%{0}%PM%{0}%PN%{0}%PO%{0}%PP%{0}%PQ%{0}%PR"
+		"%{0}%PS%{0}%PT%{0}%PU%{0}%PV%{0}%PW%{0}%PX%{0}%PY%{0}%PZ");
+	tiparm(filler[f]);
+	for (int i = 0; i < MAX_PARAMS; i++)
+		p[i] = mask & (1 << i) ? strs[i] : (char *)nums[i];
+	return tiparm_s(n, mask, fmt, p[0], p[1], p[2], p[3], p[4], p[5], p[6], p[7], p[8]);
+}
+
+/* analyze returns the number of params and the mask of string params of the
+   format, as tiparm_s only accepts those analyzed by ncurses */
+static int analyze(const char *fmt, long *nums, char **strs, int *mask) {
+	for (int n = 0; n <= MAX_PARAMS; n++)
+		for (*mask = 0; *mask < 1 << n; (*mask)++)
+			if (call(fmt, 0, n, *mask, nums, strs) != NULL)
+				return n;
+	return -1;
+}
+
+/* print prints the params and the output of a, which ends at the NUL before
+   the first byte differing from b */
+static void print(int n, int mask, long *nums, char **strs, char *a, char *b) {
+	for (int i = 0; i < n; i++) {
+		if (i != 0)
+			printf(" ");
+		if (mask & (1 << i))
+			printf("\"%s\"", strs[i]);
+		else
+			printf("%ld", nums[i]);
+	}
+	printf("\t");
+	for (int i = 0; i < FILLER && !(a[i] == 0 && a[i+1] != b[i+1]); i++)
+		printf("%02x", (unsigned char)a[i]);
+	printf("\n");
+}
+
+int main(void) {
+	static char line[65536], fmt[32768];
+	int err;
+	if (setupterm("dumb", 1, &err) != OK) {
+		fprintf(stderr, "could not setup terminal\n");
+		return 1;
+	}
+	memset(filler[0], '\xff', FILLER);
+	memset(filler[1], '\xfe', FILLER);
+	while (fgets(line, sizeof line, stdin)) {
+		long nums[MAX_PARAMS];
+		char *strs[MAX_PARAMS];
+		int mask, n;
+		char *a, *b;
+		line[strcspn(line, "\n")] = 0;
+		unhex(strtok(line, "\t"), fmt);
+		for (int i = 0; i < MAX_PARAMS; i++)
+			nums[i] = strtol(strtok(NULL, " \t"), NULL, 10);
+		for (int i = 0; i < MAX_PARAMS; i++)
+			strs[i] = strdup(strtok(NULL, " \t"));
+		if ((n = analyze(fmt, nums, strs, &mask)) < 0) {
+			printf("NULL\n");
+		} else {
+			/* the result buffer is reused, so copy the first result */
+			a = malloc(FILLER + 1);
+			memcpy(a, call(fmt, 0, n, mask, nums, strs), FILLER + 1);
+			b = call(fmt, 1, n, mask, nums, strs);
+			print(n, mask, nums, strs, a, b);
+			free(a);
+		}
+		for (int i = 0; i < MAX_PARAMS; i++)
+			free(strs[i]);
+	}
+	return 0;
+}
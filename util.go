@@ -6,7 +6,14 @@ import (
 
 const (
 	// maxFileLength is the max file length.
-	maxFileLength = 4096
+	maxFileLength = 32768
+
+	// maxLegacyLength is the max length of the header, names and standard
+	// capabilities of terminfo files with the legacy number format.
+	maxLegacyLength = 4096
+
+	// headerLength is the length of the header.
+	headerLength = 12
 
 	// magic is the file magic for terminfo files.
 	magic = 0432
@@ -34,9 +41,20 @@ const (
 	fieldExtTableSize
 )
 
+// hasNegative determines if any of the header fields in h are negative.
+func hasNegative(h []int) bool {
+	for _, v := range h {
+		if v < 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // hasInvalidCaps determines if the capabilities in h are invalid.
 func hasInvalidCaps(h []int) bool {
-	return h[fieldBoolCount] > CapCountBool ||
+	return hasNegative(h) ||
+		h[fieldBoolCount] > CapCountBool ||
 		h[fieldNumCount] > CapCountNum ||
 		h[fieldStringCount] > CapCountString
 }
@@ -51,11 +69,20 @@ func capLength(h []int) int {
 		h[fieldTableSize]
 }
 
-// hasInvalidExtOffset determines if the extended offset field is valid.
+// extOffsetCount returns the number of extended string table offsets, which
+// is one for each extended string value and one for each extended cap name.
+func extOffsetCount(h []int) int {
+	return h[fieldExtBoolCount] +
+		h[fieldExtNumCount] +
+		h[fieldExtStringCount]*2
+}
+
+// hasInvalidExtOffset determines if the extended offset field is valid. The
+// field is the number of string table entries in use, which is less than the
+// offset count when extended string values are absent or cancelled.
 func hasInvalidExtOffset(h []int) bool {
-	return h[fieldExtBoolCount]+
-		h[fieldExtNumCount]+
-		h[fieldExtStringCount]*2 != h[fieldExtOffsetCount]
+	return hasNegative(h) ||
+		h[fieldExtOffsetCount] > extOffsetCount(h)
 }
 
 // extCapLength returns the total length of extended capabilities in bytes.
@@ -63,10 +90,19 @@ func extCapLength(h []int, numWidth int) int {
 	return h[fieldExtBoolCount] +
 		h[fieldExtBoolCount]%2 + // account for word align
 		h[fieldExtNumCount]*(numWidth/8) +
-		h[fieldExtOffsetCount]*2 +
+		extOffsetCount(h)*2 +
 		h[fieldExtTableSize]
 }
 
+// maxLength returns the max length of the header, names and standard
+// capabilities for the file magic m.
+func maxLength(m int) int {
+	if m == magic {
+		return maxLegacyLength
+	}
+	return maxFileLength
+}
+
 // findNull finds the position of null in buf.
 func findNull(buf []byte, i int) int {
 	for ; i < len(buf); i++ {
@@ -79,6 +115,9 @@ func findNull(buf []byte, i int) int {
 
 // readStrings decodes n strings from string data table buf using the indexes in idx.
 func readStrings(idx []int, buf []byte, n int) (map[int][]byte, int, error) {
+	if n > len(idx) {
+		return nil, 0, ErrInvalidStringTable
+	}
 	var last int
 	m := make(map[int][]byte)
 	for i := 0; i < n; i++ {
@@ -86,6 +125,9 @@ func readStrings(idx []int, buf []byte, n int) (map[int][]byte, int, error) {
 		if start < 0 {
 			continue
 		}
+		if start >= len(buf) {
+			return nil, 0, ErrInvalidStringTable
+		}
 		if end := findNull(buf, start); end != -1 {
 			m[i], last = buf[start:end], end+1
 		} else {
@@ -128,113 +170,152 @@ func (d *decoder) readInts(n, w int) ([]int, error) {
 	for i, j := 0, 0; i < l; i, j = i+w, j+1 {
 		switch w {
 		case 1:
-			z[i] = int(buf[i])
+			z[j] = int(buf[i])
 		case 2:
 			z[j] = int(int16(buf[i+1])<<8 | int16(buf[i]))
 		case 4:
-			z[j] = int(buf[i+3])<<24 | int(buf[i+2])<<16 | int(buf[i+1])<<8 | int(buf[i])
+			z[j] = int(int32(buf[i+3])<<24 | int32(buf[i+2])<<16 | int32(buf[i+1])<<8 | int32(buf[i]))
 		}
 	}
 
 	return z, nil
 }
 
-// readBools reads the next n bools.
-func (d *decoder) readBools(n int) (map[int]bool, map[int]bool, error) {
+// readBools reads the next n bools into ti.
+func (d *decoder) readBools(ti *Terminfo, n int) error {
 	buf, err := d.readInts(n, 8)
 	if err != nil {
-		return nil, nil, err
+		return err
 	}
 
 	// process
-	bools, boolsM := make(map[int]bool), make(map[int]bool)
 	for i, b := range buf {
-		bools[i] = b == 1
-		if int8(b) == -2 {
-			boolsM[i] = true
+		switch {
+		case b == 1:
+			ti.Bools[i] = true
+			ti.BoolsP.Set(i)
+		case int8(b) == -2:
+			ti.BoolsM.Set(i)
 		}
 	}
 
-	return bools, boolsM, nil
+	return nil
 }
 
-// readNums reads the next n nums.
-func (d *decoder) readNums(n, w int) (map[int]int, map[int]bool, error) {
+// readNums reads the next n nums with width w into ti.
+func (d *decoder) readNums(ti *Terminfo, n, w int) error {
 	buf, err := d.readInts(n, w)
 	if err != nil {
-		return nil, nil, err
+		return err
 	}
 
 	// process
-	nums, numsM := make(map[int]int), make(map[int]bool)
-	for i := 0; i < n; i++ {
-		nums[i] = buf[i]
-		if buf[i] == -2 {
-			numsM[i] = true
+	for i, v := range buf {
+		switch {
+		case v >= 0:
+			ti.Nums[i] = v
+			ti.NumsP.Set(i)
+		case v == -2:
+			ti.NumsM.Set(i)
 		}
 	}
 
-	return nums, numsM, nil
+	return nil
 }
 
-// readStringTable reads the string data for n strings and the accompanying data
-// table of length sz.
-func (d *decoder) readStringTable(n, sz int) ([][]byte, []int, error) {
+// readStrings reads the next n strings and processes the string data table of
+// length sz into ti.
+func (d *decoder) readStrings(ti *Terminfo, n, sz int) error {
 	buf, err := d.readInts(n, 16)
 	if err != nil {
-		return nil, nil, err
+		return err
 	}
 
 	// read string data table
 	data, err := d.readBytes(sz)
 	if err != nil {
-		return nil, nil, err
+		return err
 	}
 
 	// align
 	d.pos += d.pos % 2
 
 	// process
-	s := make([][]byte, n)
-	var m []int
-	for i := 0; i < n; i++ {
-		start := buf[i]
-		if start == -2 {
-			m = append(m, i)
-		} else if start >= 0 {
-			if end := findNull(data, start); end != -1 {
-				s[i] = data[start:end]
-			} else {
-				return nil, nil, ErrInvalidStringTable
+	for i, start := range buf {
+		switch {
+		case start == -2:
+			ti.StringsM.Set(i)
+		case start >= sz:
+			return ErrInvalidStringTable
+		case start >= 0:
+			end := findNull(data, start)
+			if end == -1 {
+				return ErrInvalidStringTable
+			}
+			v := data[start:end]
+			if i == AcsChars {
+				v = canonicalizeAscChars(v)
 			}
+			ti.Strings[i] = v
+			ti.StringsP.Set(i)
 		}
 	}
 
-	return s, m, nil
+	return nil
 }
 
-// readStrings reads the next n strings and processes the string data table of
-// length sz.
-func (d *decoder) readStrings(n, sz int) (map[int][]byte, map[int]bool, error) {
-	s, m, err := d.readStringTable(n, sz)
+// readExtBools reads the next n extended bools, returning the bools and the
+// cancelled bools.
+func (d *decoder) readExtBools(n int) (map[int]bool, map[int]bool, error) {
+	buf, err := d.readInts(n, 8)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	strs := make(map[int][]byte)
-	for k, v := range s {
-		if k == AcsChars {
-			v = canonicalizeAscChars(v)
+	// process
+	bools, boolsM := make(map[int]bool, n), make(map[int]bool)
+	for i, b := range buf {
+		bools[i] = b == 1
+		if int8(b) == -2 {
+			boolsM[i] = true
 		}
-		strs[k] = v
 	}
 
-	strsM := make(map[int]bool, len(m))
-	for _, k := range m {
-		strsM[k] = true
+	return bools, boolsM, nil
+}
+
+// readExtNums reads the next n extended nums with width w, returning the
+// present nums and the cancelled nums.
+func (d *decoder) readExtNums(n, w int) (map[int]int, map[int]bool, error) {
+	buf, err := d.readInts(n, w)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// process
+	nums, numsM := make(map[int]int, n), make(map[int]bool)
+	for i, v := range buf {
+		switch {
+		case v >= 0:
+			nums[i] = v
+		case v == -2:
+			numsM[i] = true
+		}
 	}
 
-	return strs, strsM, nil
+	return nums, numsM, nil
+}
+
+// cancelledStrings returns the cancelled strings of the first n string table
+// indexes in idx.
+func cancelledStrings(idx []int, n int) map[int]bool {
+	m := make(map[int]bool)
+	for i := 0; i < n; i++ {
+		if idx[i] == -2 {
+			m[i] = true
+		}
+	}
+	return m
 }
 
 // canonicalizeAscChars reorders chars to be unique, in order.
@@ -243,11 +324,10 @@ func (d *decoder) readStrings(n, sz int) (map[int][]byte, map[int]bool, error) {
 func canonicalizeAscChars(z []byte) []byte {
 	var c chars
 	enc := make(map[byte]byte, len(z)/2)
-	for i := 0; i < len(z); i += 2 {
+	for i := 0; i+1 < len(z); i += 2 {
 		if _, ok := enc[z[i]]; !ok {
 			a, b := z[i], z[i+1]
-			//log.Printf(">>> a: %d %c, b: %d %c", a, a, b, b)
-			c, enc[a] = append(c, b), b
+			c, enc[a] = append(c, a), b
 		}
 	}
 	sort.Sort(c)
@@ -15,7 +15,7 @@ var termNameCache = struct {
 
 var fileRE = regexp.MustCompile("^([0-9]+|[a-zA-Z])/")
 
-func terms(t *testing.T) map[string]string {
+func terms(t testing.TB) map[string]string {
 	termNameCache.Lock()
 	defer termNameCache.Unlock()
 
@@ -51,19 +51,49 @@ func terms(t *testing.T) map[string]string {
 	return termNameCache.names
 }
 
+// termDir is the corpus of compiled entries used by the tests, so that the
+// tests do not depend on the host's terminfo database.
+const termDir = "testdata/terminfo"
+
 func termDirs() []string {
-	var dirs []string
-	for _, dir := range []string{"/lib/terminfo", "/usr/share/terminfo"} {
-		fi, err := os.Stat(dir)
-		if err != nil && os.IsNotExist(err) {
-			continue
-		} else if err != nil {
-			panic(err)
-		}
-		if fi.IsDir() {
-			dirs = append(dirs, dir)
-		}
+	dir, err := filepath.Abs(termDir)
+	if err != nil {
+		panic(err)
+	}
+	return []string{dir}
+}
+
+// withCaps sets the bool, num and string caps on ti.
+func withCaps(ti *Terminfo, bools []int, nums map[int]int, strs map[int]string) *Terminfo {
+	for _, i := range bools {
+		ti.Bools[i] = true
+		ti.BoolsP.Set(i)
 	}
+	for i, v := range nums {
+		ti.Nums[i] = v
+		ti.NumsP.Set(i)
+	}
+	for i, v := range strs {
+		ti.Strings[i] = []byte(v)
+		ti.StringsP.Set(i)
+	}
+	return ti
+}
 
-	return dirs
+func TestCanonicalizeAscChars(t *testing.T) {
+	tests := []struct {
+		s, exp string
+	}{
+		{"", ""},
+		{"x3q-j+", "j+q-x3"},
+		// the first definition wins
+		{"qqxxqa", "qqxx"},
+		// odd lengths ignore the last char
+		{"x3q", "x3"},
+	}
+	for i, test := range tests {
+		if s := string(canonicalizeAscChars([]byte(test.s))); s != test.exp {
+			t.Errorf("test %d %q expected %q, got: %q", i, test.s, test.exp, s)
+		}
+	}
 }